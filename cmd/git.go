@@ -41,9 +41,10 @@ func setupGitConfig(owner, token, name, email string, hideRepoInfo bool) error {
 	return nil
 }
 
-// hasReadmeChanged checks if README.md has changed
-func hasReadmeChanged() (bool, error) {
-	cmd := exec.Command("git", "status", "--porcelain", "README.md")
+// hasFilesChanged checks if any of the target files or generated files has changed
+func hasFilesChanged(paths ...string) (bool, error) {
+	args := append([]string{"status", "--porcelain", "--"}, paths...)
+	cmd := exec.Command("git", args...)
 	output, err := cmd.Output()
 	if err != nil {
 		return false, err
@@ -52,17 +53,18 @@ func hasReadmeChanged() (bool, error) {
 	return strings.TrimSpace(string(output)) != "", nil
 }
 
-// commitAndPushReadme Commit and push changes if README.md has changed
-func commitAndPushReadme(msg, branch string, hideRepoInfo bool) error {
+// commitAndPushFiles stages the target files and generated files,
+// then commits and pushes them together in a single commit
+func commitAndPushFiles(msg, branch string, hideRepoInfo bool, paths ...string) error {
 	if branch == "" {
 		branch = "main"
 	}
 
 	if msg == "" {
-		msg = "📝 Update README.md"
+		msg = "📝 Update stats"
 	}
 
-	if err := runGitCommand(hideRepoInfo, "add", "README.md"); err != nil {
+	if err := runGitCommand(hideRepoInfo, append([]string{"add", "--"}, paths...)...); err != nil {
 		return err
 	}
 
@@ -73,6 +75,16 @@ func commitAndPushReadme(msg, branch string, hideRepoInfo bool) error {
 	return runGitCommand(hideRepoInfo, "push", "origin", branch)
 }
 
+// stageRemovedFiles stages the deletion of files removed from the working tree,
+// ignoring the ones git never tracked
+func stageRemovedFiles(hideRepoInfo bool, paths ...string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	return runGitCommand(hideRepoInfo, append([]string{"rm", "--cached", "--quiet", "--ignore-unmatch", "--"}, paths...)...)
+}
+
 func runGitCommand(hideRepoInfo bool, args ...string) error {
 	cmd := exec.Command("git", args...)
 	var stdout, stderr bytes.Buffer
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 	_ "time/tzdata"
@@ -15,6 +16,7 @@ import (
 	"github.com/thanhhaudev/github-stats/pkg/container"
 	"github.com/thanhhaudev/github-stats/pkg/github"
 	"github.com/thanhhaudev/github-stats/pkg/wakatime"
+	"github.com/thanhhaudev/github-stats/pkg/writer"
 )
 
 func main() {
@@ -29,6 +31,14 @@ func main() {
 		logger.Fatalf("❌ Configuration error: %v", err)
 	}
 
+	if err := cfg.RegisterProgressBarStyles(); err != nil {
+		logger.Fatalf("❌ Configuration error: %v", err)
+	}
+
+	if err := writer.SetLocale(cfg.Locale); err != nil {
+		logger.Fatalf("❌ Configuration error: %v", err)
+	}
+
 	cl, err := setClock(logger, cfg)
 	if err != nil {
 		panic(err)
@@ -40,7 +50,7 @@ func main() {
 	defer cancel()
 
 	gc := github.NewGitHub(cfg.GitHubToken, cfg.Debug, cfg.HideRepoInfo)
-	wc := wakatime.NewWakaTime(logger, cfg.WakaTimeAPIKey, wakatime.StatsRange(cfg.WakaTimeRange))
+	wc := wakatime.NewWakaTime(logger, cfg.WakaTimeAPIKey, cfg.WakaTimeAPIURL, wakatime.StatsRange(cfg.WakaTimeRange))
 	dc := container.NewDataContainer(logger, container.NewClientManager(wc, gc), cfg)
 	dc.SetClock(cl)
 	if err := runGroupedStep(logger, "Build data container", cfg.EnableGitHubGroups, func() error {
@@ -49,12 +59,42 @@ func main() {
 		logger.Fatalln(err)
 	}
 
+	var removed []string
 	err = runGroupedStep(logger, "Update README", cfg.EnableGitHubGroups, func() error {
-		logger.Println("📝 Updating README.md...")
-		return updateReadme(dc.GetStats(cl), cfg.SectionName)
+		logger.Printf("📝 Updating %s...\n", strings.Join(targetFilePaths(cfg.TargetFiles), ", "))
+		stats, err := dc.GetStats(cl)
+		if err != nil {
+			return err
+		}
+
+		if cfg.JSONOutputFile != "" {
+			b, err := dc.ExportJSON()
+			if err != nil {
+				return err
+			}
+
+			dc.Files = append(dc.Files, container.OutputFile{Path: cfg.JSONOutputFile, Content: b})
+		}
+
+		if err := writeOutputFiles(dc.Files); err != nil {
+			return err
+		}
+
+		removed, err = removeStaleSVGs(cfg.SVGDir, dc.Files)
+		if err != nil {
+			return err
+		}
+
+		for _, f := range cfg.TargetFiles {
+			if err := updateTargetFile(f.Path, f.SectionName, stats.RelativeTo(filepath.Dir(f.Path), dc.Files)); err != nil {
+				return err
+			}
+		}
+
+		return nil
 	})
 	if err != nil {
-		logger.Fatalf("Error updating README.md: %v", err)
+		logger.Fatalf("Error updating target files: %v", err)
 	}
 
 	if !cfg.DryRun {
@@ -79,14 +119,19 @@ func main() {
 		}
 
 		err = runGroupedStep(logger, "Commit and push README", cfg.EnableGitHubGroups, func() error {
-			changed, err := hasReadmeChanged()
+			paths := append(targetFilePaths(cfg.TargetFiles), outputFilePaths(dc.Files)...)
+			changed, err := hasFilesChanged(append(paths, removed...)...)
 			if err != nil {
 				return err
 			}
 
 			if changed {
 				logger.Println("📤 Committing and pushing changes...")
-				return commitAndPushReadme(cfg.CommitMessage, cfg.BranchName, cfg.HideRepoInfo)
+				if err := stageRemovedFiles(cfg.HideRepoInfo, removed...); err != nil {
+					return err
+				}
+
+				return commitAndPushFiles(cfg.CommitMessage, cfg.BranchName, cfg.HideRepoInfo, paths...)
 			}
 
 			logger.Println("📤 No changes to commit, skipping...")
@@ -126,29 +171,138 @@ func withClock(ctx context.Context, cl clock.Clock) context.Context {
 	return context.WithValue(ctx, clock.ClockKey{}, cl)
 }
 
-// updateReadme updates the README.md file with the provided stats
-func updateReadme(u, n string) error {
-	f := "README.md"
-	b, err := os.ReadFile("README.md")
+// updateTargetFile fills the marker sections named n in file f with the provided stats
+func updateTargetFile(f, n string, u *container.Stats) error {
+	b, err := os.ReadFile(f)
 	if err != nil {
 		return err
 	}
 
+	c, err := fillSections(string(b), n, u)
+	if err != nil {
+		return fmt.Errorf("%w in %s", err, f)
+	}
+
+	return os.WriteFile(f, []byte(c), 0644)
+}
+
+// fillSections replaces the content of every per-metric section, e.g.
+// <!--START_SECTION:readme-stats:CODING_STREAK-->, with that metric's output,
+// then fills the main section with the metrics that were not placed on their own.
+// A missing main section is only an error when no per-metric section was found either.
+func fillSections(c, n string, u *container.Stats) (string, error) {
 	if n == "" {
 		n = "readme-stats"
 	}
 
+	placed := make(map[string]bool)
+	for _, k := range u.Order {
+		var ok bool
+		if c, ok = replaceSection(c, n+":"+k, u.Metrics[k]); ok {
+			placed[k] = true
+		}
+	}
+
 	s := fmt.Sprintf("<!--START_SECTION:%s-->", n)
 	e := fmt.Sprintf("<!--END_SECTION:%s-->", n)
 
-	si := strings.Index(string(b), s)
-	ei := strings.Index(string(b), e)
+	c, ok := replaceSection(c, n, u.Section(placed))
+	if !ok && len(placed) == 0 {
+		return "", fmt.Errorf("section tags %s or %s not found", s, e)
+	}
+
+	return c, nil
+}
 
-	if si == -1 || ei == -1 {
-		return fmt.Errorf("section tags %s or %s not found in %s", s, e, f)
+// replaceSection replaces every <!--START_SECTION:n--> ... <!--END_SECTION:n--> block in c
+func replaceSection(c, n, u string) (string, bool) {
+	s := fmt.Sprintf("<!--START_SECTION:%s-->", n)
+	e := fmt.Sprintf("<!--END_SECTION:%s-->", n)
+
+	found := false
+	for from := 0; ; {
+		si := strings.Index(c[from:], s)
+		if si == -1 {
+			break
+		}
+		si += from
+
+		ei := strings.Index(c[si:], e)
+		if ei == -1 {
+			break
+		}
+		ei += si
+
+		c = c[:si+len(s)] + "\n" + u + "\n" + c[ei:]
+		from = si + len(s) + len(u) + 2 + len(e)
+		found = true
+	}
+
+	return c, found
+}
+
+// writeOutputFiles writes the files generated alongside the README, such as SVG cards
+func writeOutputFiles(files []container.OutputFile) error {
+	for _, f := range files {
+		if dir := filepath.Dir(f.Path); dir != "." {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return err
+			}
+		}
+
+		if err := os.WriteFile(f.Path, f.Content, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeStaleSVGs deletes the SVG cards in dir that this run no longer generates, e.g. after a
+// metric is removed from SHOW_METRICS or switched to another render style, and returns their paths
+func removeStaleSVGs(dir string, files []container.OutputFile) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, writer.SVGFileName("*")))
+	if err != nil {
+		return nil, err
+	}
+
+	generated := make(map[string]bool, len(files))
+	for _, f := range files {
+		generated[filepath.Clean(f.Path)] = true
+	}
+
+	var removed []string
+	for _, path := range matches {
+		if generated[filepath.Clean(path)] {
+			continue
+		}
+
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+
+		removed = append(removed, path)
 	}
 
-	u = string(b)[:si+len(s)] + "\n" + u + "\n" + string(b)[ei:]
+	return removed, nil
+}
+
+// outputFilePaths returns the paths of the generated files
+func outputFilePaths(files []container.OutputFile) []string {
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		paths = append(paths, f.Path)
+	}
+
+	return paths
+}
+
+// targetFilePaths returns the paths of the target files
+func targetFilePaths(files []config.TargetFile) []string {
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		paths = append(paths, f.Path)
+	}
 
-	return os.WriteFile(f, []byte(u), 0644)
+	return paths
 }
@@ -2,11 +2,24 @@ package main
 
 import (
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/thanhhaudev/github-stats/pkg/container"
 )
 
-func TestUpdateReadmeReplacesOnlyConfiguredSection(t *testing.T) {
+func newStats(metrics ...string) *container.Stats {
+	s := &container.Stats{Metrics: map[string]string{}}
+	for i := 0; i+1 < len(metrics); i += 2 {
+		s.Order = append(s.Order, metrics[i])
+		s.Metrics[metrics[i]] = metrics[i+1]
+	}
+
+	return s
+}
+
+func TestUpdateTargetFileReplacesOnlyConfiguredSection(t *testing.T) {
 	dir := t.TempDir()
 	t.Chdir(dir)
 
@@ -27,8 +40,8 @@ func TestUpdateReadmeReplacesOnlyConfiguredSection(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err := updateReadme("new stats", "readme-stats"); err != nil {
-		t.Fatalf("updateReadme returned error: %v", err)
+	if err := updateTargetFile("README.md", "readme-stats", newStats("CODING_STREAK", "new stats")); err != nil {
+		t.Fatalf("updateTargetFile returned error: %v", err)
 	}
 
 	b, err := os.ReadFile("README.md")
@@ -46,7 +59,7 @@ func TestUpdateReadmeReplacesOnlyConfiguredSection(t *testing.T) {
 	}
 }
 
-func TestUpdateReadmeReturnsErrorWhenSectionMissing(t *testing.T) {
+func TestUpdateTargetFileReturnsErrorWhenSectionMissing(t *testing.T) {
 	dir := t.TempDir()
 	t.Chdir(dir)
 
@@ -54,7 +67,7 @@ func TestUpdateReadmeReturnsErrorWhenSectionMissing(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err := updateReadme("new stats", "readme-stats")
+	err := updateTargetFile("README.md", "readme-stats", newStats("CODING_STREAK", "new stats"))
 	if err == nil {
 		t.Fatal("expected missing section error, got nil")
 	}
@@ -62,3 +75,115 @@ func TestUpdateReadmeReturnsErrorWhenSectionMissing(t *testing.T) {
 		t.Fatalf("expected section tag error, got %v", err)
 	}
 }
+
+func TestFillSectionsPlacesMetricsInOwnSections(t *testing.T) {
+	readme := strings.Join([]string{
+		"<!--START_SECTION:readme-stats-->",
+		"old",
+		"<!--END_SECTION:readme-stats-->",
+		"## Streak",
+		"<!--START_SECTION:readme-stats:CODING_STREAK-->",
+		"old streak",
+		"<!--END_SECTION:readme-stats:CODING_STREAK-->",
+	}, "\n")
+
+	s := newStats("COMMIT_DAYS_OF_WEEK", "days", "CODING_STREAK", "streak")
+	s.LastUpdated = "updated"
+
+	got, err := fillSections(readme, "readme-stats", s)
+	if err != nil {
+		t.Fatalf("fillSections returned error: %v", err)
+	}
+
+	want := strings.Join([]string{
+		"<!--START_SECTION:readme-stats-->",
+		"daysupdated",
+		"<!--END_SECTION:readme-stats-->",
+		"## Streak",
+		"<!--START_SECTION:readme-stats:CODING_STREAK-->",
+		"streak",
+		"<!--END_SECTION:readme-stats:CODING_STREAK-->",
+	}, "\n")
+	if got != want {
+		t.Fatalf("unexpected README:\n%s", got)
+	}
+}
+
+func TestFillSectionsWithoutMainSection(t *testing.T) {
+	tests := []struct {
+		name    string
+		section string
+		readme  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "metric section only",
+			section: "readme-stats",
+			readme:  "a<!--START_SECTION:readme-stats:CODING_STREAK--><!--END_SECTION:readme-stats:CODING_STREAK-->b",
+			want:    "a<!--START_SECTION:readme-stats:CODING_STREAK-->\nstreak\n<!--END_SECTION:readme-stats:CODING_STREAK-->b",
+		},
+		{
+			name:    "same metric twice",
+			section: "x",
+			readme:  "<!--START_SECTION:x:CODING_STREAK--><!--END_SECTION:x:CODING_STREAK--> <!--START_SECTION:x:CODING_STREAK--><!--END_SECTION:x:CODING_STREAK-->",
+			want:    "<!--START_SECTION:x:CODING_STREAK-->\nstreak\n<!--END_SECTION:x:CODING_STREAK--> <!--START_SECTION:x:CODING_STREAK-->\nstreak\n<!--END_SECTION:x:CODING_STREAK-->",
+		},
+		{
+			name:    "section of another metric",
+			section: "readme-stats",
+			readme:  "<!--START_SECTION:readme-stats:LANGUAGE_PER_REPO--><!--END_SECTION:readme-stats:LANGUAGE_PER_REPO-->",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fillSections(tt.readme, tt.section, newStats("CODING_STREAK", "streak"))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("fillSections returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveStaleSVGsKeepsGeneratedAndUnrelatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	for _, name := range []string{"cards/github-stats-commit-calendar.svg", "cards/github-stats-coding-streak.svg", "cards/logo.svg"} {
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte("<svg/>"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	removed, err := removeStaleSVGs("cards", []container.OutputFile{{Path: "cards/github-stats-coding-streak.svg"}})
+	if err != nil {
+		t.Fatalf("removeStaleSVGs returned error: %v", err)
+	}
+
+	if len(removed) != 1 || removed[0] != filepath.Join("cards", "github-stats-commit-calendar.svg") {
+		t.Fatalf("expected only the stale calendar card removed, got %v", removed)
+	}
+	for name, want := range map[string]bool{
+		"cards/github-stats-commit-calendar.svg": false,
+		"cards/github-stats-coding-streak.svg":   true,
+		"cards/logo.svg":                         true,
+	} {
+		if _, err := os.Stat(name); (err == nil) != want {
+			t.Fatalf("%s exists = %v, want %v", name, err == nil, want)
+		}
+	}
+}
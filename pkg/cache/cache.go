@@ -45,6 +45,12 @@ type WakaTimeEntry struct {
 	Range    string                           `json:"range"`
 	Stats    *wakatime.Stats                  `json:"stats"`
 	AllTime  *wakatime.AllTimeSinceTodayStats `json:"allTime"`
+
+	// Daily summaries and goals are fetched separately from the stats snapshot,
+	// so they survive a SetWakaTime refresh; summaries are keyed by their window.
+	SummaryDays int                 `json:"summaryDays,omitempty"`
+	Summaries   *wakatime.Summaries `json:"summaries,omitempty"`
+	Goals       *wakatime.Goals     `json:"goals,omitempty"`
 }
 
 type Cache struct {
@@ -177,13 +183,20 @@ func (c *Cache) SetWakaTime(statsRange string, stats *wakatime.Stats, allTime *w
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.WakaTime = &WakaTimeEntry{
+	entry := &WakaTimeEntry{
 		Version:  WakaTimeSchemaVersion,
 		CachedAt: time.Now().UTC(),
 		Range:    statsRange,
 		Stats:    stats,
 		AllTime:  allTime,
 	}
+	if c.WakaTime != nil {
+		entry.SummaryDays = c.WakaTime.SummaryDays
+		entry.Summaries = c.WakaTime.Summaries
+		entry.Goals = c.WakaTime.Goals
+	}
+
+	c.WakaTime = entry
 }
 
 func (c *Cache) LookupWakaTime(statsRange string) (*wakatime.Stats, *wakatime.AllTimeSinceTodayStats, bool) {
@@ -197,6 +210,59 @@ func (c *Cache) LookupWakaTime(statsRange string) (*wakatime.Stats, *wakatime.Al
 	return c.WakaTime.Stats, c.WakaTime.AllTime, true
 }
 
+func (c *Cache) SetWakaTimeSummaries(days int, summaries *wakatime.Summaries) {
+	if summaries == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.WakaTime == nil {
+		c.WakaTime = &WakaTimeEntry{Version: WakaTimeSchemaVersion, CachedAt: time.Now().UTC()}
+	}
+
+	c.WakaTime.SummaryDays = days
+	c.WakaTime.Summaries = summaries
+}
+
+func (c *Cache) LookupWakaTimeSummaries(days int) (*wakatime.Summaries, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.WakaTime == nil || c.WakaTime.Summaries == nil || c.WakaTime.SummaryDays != days {
+		return nil, false
+	}
+
+	return c.WakaTime.Summaries, true
+}
+
+func (c *Cache) SetWakaTimeGoals(goals *wakatime.Goals) {
+	if goals == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.WakaTime == nil {
+		c.WakaTime = &WakaTimeEntry{Version: WakaTimeSchemaVersion, CachedAt: time.Now().UTC()}
+	}
+
+	c.WakaTime.Goals = goals
+}
+
+func (c *Cache) LookupWakaTimeGoals() (*wakatime.Goals, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.WakaTime == nil || c.WakaTime.Goals == nil {
+		return nil, false
+	}
+
+	return c.WakaTime.Goals, true
+}
+
 // Prune removes entries whose URL is not in keepURLs. Used to drop cache for
 // repos that have been deleted or transferred so they stop inflating stats.
 func (c *Cache) Prune(keepURLs []string) {
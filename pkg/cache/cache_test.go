@@ -158,6 +158,52 @@ func TestLookupWakaTime_MissesWhenRangeChanged(t *testing.T) {
 	}
 }
 
+func TestSaveLoadRoundTrip_WakaTimeSummaries(t *testing.T) {
+	path := tempCachePath(t)
+	summaries := &wakatime.Summaries{Data: []wakatime.Summary{{GrandTotal: wakatime.GrandTotal{Text: "2 hrs", TotalSeconds: 7200}}}}
+
+	original := &Cache{Version: RepoSchemaVersion, Repos: make(map[string]*RepoEntry)}
+	original.SetWakaTimeSummaries(7, summaries)
+	if err := original.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := Load(path, false)
+	got, ok := loaded.LookupWakaTimeSummaries(7)
+	if !ok {
+		t.Fatal("expected cached WakaTime summaries after round-trip")
+	}
+	if len(got.Data) != 1 || got.Data[0].GrandTotal.Text != "2 hrs" {
+		t.Errorf("summaries not round-tripped: %+v", got)
+	}
+	if _, ok := loaded.LookupWakaTimeSummaries(14); ok {
+		t.Error("expected miss when cached summaries window differs")
+	}
+	if _, _, ok := loaded.LookupWakaTime("last_7_days"); ok {
+		t.Error("summaries alone must not satisfy a stats lookup")
+	}
+}
+
+func TestSetWakaTime_KeepsCachedGoals(t *testing.T) {
+	c := &Cache{Repos: make(map[string]*RepoEntry)}
+	c.SetWakaTimeGoals(&wakatime.Goals{Total: 1})
+	c.SetWakaTime("last_7_days", &wakatime.Stats{}, nil)
+
+	if goals, ok := c.LookupWakaTimeGoals(); !ok || goals.Total != 1 {
+		t.Fatalf("expected goals to survive a stats refresh, got %+v", goals)
+	}
+}
+
+func TestSetWakaTime_KeepsCachedSummaries(t *testing.T) {
+	c := &Cache{Repos: make(map[string]*RepoEntry)}
+	c.SetWakaTimeSummaries(7, &wakatime.Summaries{})
+	c.SetWakaTime("last_7_days", &wakatime.Stats{}, nil)
+
+	if _, ok := c.LookupWakaTimeSummaries(7); !ok {
+		t.Fatal("expected refreshing the stats snapshot to keep the cached summaries")
+	}
+}
+
 func TestSaveLoadRoundTrip_DoesNotLeakRepoMetadata(t *testing.T) {
 	// Guard against accidentally re-introducing fields like IsPrivate or
 	// repo Name in the cache file. The schema must stay minimal so a leaked
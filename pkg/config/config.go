@@ -2,11 +2,14 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/thanhhaudev/github-stats/pkg/wakatime"
+	"github.com/thanhhaudev/github-stats/pkg/writer"
 )
 
 // Valid metric keys for SHOW_METRICS
@@ -18,6 +21,18 @@ const (
 	MetricWakaTimeSpentTime = "WAKATIME_SPENT_TIME"
 	MetricCodingStreak      = "CODING_STREAK"
 	MetricWakaTimeAIStats   = "WAKATIME_AI_STATS"
+	MetricCommitCalendar    = "COMMIT_CALENDAR"
+	MetricCommitsPerYear    = "COMMITS_PER_YEAR"
+	MetricCommitsPerQuarter = "COMMITS_PER_QUARTER"
+	MetricCodeChurn         = "CODE_CHURN"
+	MetricCommitHours       = "COMMIT_HOURS"
+	MetricCommitPunchCard   = "COMMIT_PUNCH_CARD"
+	MetricProfileSummary    = "PROFILE_SUMMARY"
+	MetricTopRepositories   = "TOP_REPOSITORIES"
+	MetricCommitSizes       = "COMMIT_SIZES"
+	MetricCommitTrend       = "COMMIT_TREND"
+	MetricWakaTimeDaily     = "WAKATIME_DAILY"
+	MetricWakaTimeGoals     = "WAKATIME_GOALS"
 )
 
 // Valid data types for WAKATIME_DATA
@@ -26,12 +41,38 @@ const (
 	WakaDataLanguages        = "LANGUAGES"
 	WakaDataProjects         = "PROJECTS"
 	WakaDataOperatingSystems = "OPERATING_SYSTEMS"
+	WakaDataCategories       = "CATEGORIES"
+	WakaDataMachines         = "MACHINES"
+	WakaDataDependencies     = "DEPENDENCIES"
+	WakaDataBranches         = "BRANCHES"
 )
 
-// Valid progress bar versions
+// Built-in progress bar styles for PROGRESS_BAR_VERSION and METRIC_PROGRESS_BARS
 const (
-	ProgressBarVersion1 = "1"
-	ProgressBarVersion2 = "2"
+	ProgressBarVersion1 = writer.ProgressBarBlocks
+	ProgressBarVersion2 = writer.ProgressBarEmoji
+	ProgressBarEighths  = writer.ProgressBarEighths
+)
+
+// Valid breakdowns for CODE_CHURN_PERIOD
+const (
+	ChurnPeriodYear  = "year"
+	ChurnPeriodMonth = "month"
+)
+
+// Valid periods for COMMIT_TREND_PERIOD
+const (
+	TrendPeriodMonth = "month"
+	TrendPeriodWeek  = "week"
+)
+
+// Valid render styles for RENDER_STYLE and METRIC_STYLES
+const (
+	RenderStyleText    = "text"
+	RenderStyleSVG     = "svg"
+	RenderStyleTable   = "table"
+	RenderStylePie     = "pie"     // METRIC_STYLES only
+	RenderStyleXYChart = "xychart" // METRIC_STYLES only
 )
 
 // Boolean string values
@@ -40,23 +81,52 @@ const (
 	FalseVal = "false"
 )
 
+// TargetFile is a file whose marker section is filled with the stats
+type TargetFile struct {
+	Path        string
+	SectionName string
+}
+
 // Config holds all environment variables used in the application
 type Config struct {
 	// GitHub settings
 	GitHubToken string
 
 	// WakaTime settings
-	WakaTimeAPIKey string
-	WakaTimeRange  string
-	WakaTimeData   []string
+	WakaTimeAPIKey      string
+	WakaTimeAPIURL      string // base URL of a WakaTime-compatible API; empty uses wakatime.com
+	WakaTimeRange       string
+	WakaTimeData        []string
+	WakaTimeDailyDays   int
+	WakaTimeWaitSeconds int // how long to poll WakaTime while it is still processing; 0 disables polling
 
 	// Display settings
 	ShowMetrics              []string
 	ShowLastUpdate           bool
 	TimeLayout               string
 	TimeZone                 string
+	Locale                   string
 	ProgressBarVersion       string
+	ProgressBarStyles        map[string]string // PROGRESS_BAR_STYLES definitions by name
+	MetricProgressBars       map[string]string
 	SimplifyCommitTimesTitle bool
+	CommitTimePeriods        string // COMMIT_TIME_PERIODS definition, parsed by DayPeriods
+	CommitsPerYearLimit      int
+	CommitsPerQuarterLimit   int
+	CodeChurnPeriod          string
+	TopRepositoriesLimit     int
+	CommitTrendPeriod        string
+	CommitTrendWindow        int
+	TopStreaks               int
+	RenderStyle              string
+	MetricStyles             map[string]string
+	HeadingLevel             int
+	MetricHeadings           map[string]string
+	MetricDetails            map[string]string // METRIC_DETAILS summaries by metric; empty uses the title
+	SVGTheme                 string
+	SVGDir                   string
+	JSONOutputFile           string
+	TemplateFile             string
 
 	// Git settings
 	DryRun             bool
@@ -68,6 +138,7 @@ type Config struct {
 	CommitMessage      string
 	BranchName         string
 	SectionName        string
+	TargetFiles        []TargetFile
 
 	// Repository settings
 	HideRepoInfo     bool
@@ -86,17 +157,40 @@ func Load() *Config {
 		GitHubToken: os.Getenv("GITHUB_TOKEN"),
 
 		// WakaTime settings
-		WakaTimeAPIKey: os.Getenv("WAKATIME_API_KEY"),
-		WakaTimeRange:  os.Getenv("WAKATIME_RANGE"),
-		WakaTimeData:   splitEnv("WAKATIME_DATA"),
+		WakaTimeAPIKey:      os.Getenv("WAKATIME_API_KEY"),
+		WakaTimeAPIURL:      os.Getenv("WAKATIME_API_URL"),
+		WakaTimeRange:       os.Getenv("WAKATIME_RANGE"),
+		WakaTimeData:        splitEnv("WAKATIME_DATA"),
+		WakaTimeDailyDays:   intEnv("WAKATIME_DAILY_DAYS"),
+		WakaTimeWaitSeconds: intEnv("WAKATIME_WAIT_SECONDS"),
 
 		// Display settings
 		ShowMetrics:              splitEnv("SHOW_METRICS"),
 		ShowLastUpdate:           os.Getenv("SHOW_LAST_UPDATE") == TrueVal,
 		TimeLayout:               os.Getenv("TIME_LAYOUT"),
 		TimeZone:                 os.Getenv("TIME_ZONE"),
+		Locale:                   os.Getenv("LOCALE"),
 		ProgressBarVersion:       os.Getenv("PROGRESS_BAR_VERSION"),
+		ProgressBarStyles:        parsePairs(splitEnvBy("PROGRESS_BAR_STYLES", ";")),
+		MetricProgressBars:       parsePairs(splitEnv("METRIC_PROGRESS_BARS")),
 		SimplifyCommitTimesTitle: os.Getenv("SIMPLIFY_COMMIT_TIMES_TITLE") == TrueVal,
+		CommitTimePeriods:        os.Getenv("COMMIT_TIME_PERIODS"),
+		CommitsPerYearLimit:      intEnv("COMMITS_PER_YEAR_LIMIT"),
+		CommitsPerQuarterLimit:   intEnv("COMMITS_PER_QUARTER_LIMIT"),
+		CodeChurnPeriod:          os.Getenv("CODE_CHURN_PERIOD"),
+		TopRepositoriesLimit:     intEnv("TOP_REPOSITORIES_LIMIT"),
+		CommitTrendPeriod:        os.Getenv("COMMIT_TREND_PERIOD"),
+		CommitTrendWindow:        intEnv("COMMIT_TREND_WINDOW"),
+		TopStreaks:               intEnv("TOP_STREAKS"),
+		RenderStyle:              os.Getenv("RENDER_STYLE"),
+		MetricStyles:             parsePairs(splitEnv("METRIC_STYLES")),
+		HeadingLevel:             intEnv("HEADING_LEVEL"),
+		MetricHeadings:           parsePairs(splitEnv("METRIC_HEADINGS")),
+		MetricDetails:            parsePairs(splitEnvBy("METRIC_DETAILS", ";")),
+		SVGTheme:                 os.Getenv("SVG_THEME"),
+		SVGDir:                   os.Getenv("SVG_DIR"),
+		JSONOutputFile:           os.Getenv("JSON_OUTPUT_FILE"),
+		TemplateFile:             os.Getenv("TEMPLATE_FILE"),
 
 		// Git settings
 		DryRun:             os.Getenv("DRY_RUN") == TrueVal,
@@ -108,6 +202,7 @@ func Load() *Config {
 		CommitMessage:      os.Getenv("COMMIT_MESSAGE"),
 		BranchName:         os.Getenv("BRANCH_NAME"),
 		SectionName:        os.Getenv("SECTION_NAME"),
+		TargetFiles:        parseTargetFiles(splitEnv("TARGET_FILES")),
 
 		// Repository settings
 		HideRepoInfo:     os.Getenv("HIDE_REPO_INFO") == TrueVal,
@@ -130,18 +225,64 @@ func (c *Config) applyDefaults() {
 		c.WakaTimeRange = string(wakatime.StatsRangeLast7Days)
 	}
 
+	if c.WakaTimeDailyDays == 0 {
+		c.WakaTimeDailyDays = 7
+	}
+
 	if c.BranchName == "" {
 		c.BranchName = "main"
 	}
 
 	if c.CommitMessage == "" {
-		c.CommitMessage = "📝 Update README.md"
+		c.CommitMessage = "📝 Update stats"
 	}
 
 	if c.SectionName == "" {
 		c.SectionName = "readme-stats"
 	}
 
+	if len(c.TargetFiles) == 0 {
+		c.TargetFiles = []TargetFile{{Path: "README.md"}}
+	}
+
+	for i := range c.TargetFiles {
+		if c.TargetFiles[i].SectionName == "" {
+			c.TargetFiles[i].SectionName = c.SectionName
+		}
+	}
+
+	if c.Locale == "" {
+		c.Locale = writer.DefaultLocale
+	}
+
+	if c.TopRepositoriesLimit == 0 {
+		c.TopRepositoriesLimit = 5
+	}
+
+	if c.CommitTrendPeriod == "" {
+		c.CommitTrendPeriod = TrendPeriodMonth
+	}
+
+	if c.CommitTrendWindow == 0 {
+		c.CommitTrendWindow = 12
+	}
+
+	if c.TopStreaks == 0 {
+		c.TopStreaks = 3
+	}
+
+	if c.CodeChurnPeriod == "" {
+		c.CodeChurnPeriod = ChurnPeriodYear
+	}
+
+	if c.RenderStyle == "" {
+		c.RenderStyle = RenderStyleText
+	}
+
+	if c.SVGTheme == "" {
+		c.SVGTheme = writer.SVGThemeAuto
+	}
+
 	if c.CacheFile == "" {
 		c.CacheFile = ".github-stats-cache.json"
 	}
@@ -159,11 +300,66 @@ func (c *Config) applyDefaults() {
 
 // splitEnv splits a comma-separated environment variable into a slice
 func splitEnv(key string) []string {
+	return splitEnvBy(key, ",")
+}
+
+// splitEnvBy splits an environment variable on sep into a slice
+func splitEnvBy(key, sep string) []string {
 	value := os.Getenv(key)
 	if value == "" {
 		return []string{}
 	}
-	return strings.Split(value, ",")
+	return strings.Split(value, sep)
+}
+
+// intEnv reads a non-negative integer environment variable. Unset means 0;
+// values that are not a number return -1 so Validate can report them.
+func intEnv(key string) int {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return 0
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return -1
+	}
+
+	return n
+}
+
+// parsePairs parses KEY=value entries into a map; an entry without "=" maps to an empty value
+func parsePairs(entries []string) map[string]string {
+	pairs := make(map[string]string, len(entries))
+	for _, e := range entries {
+		if strings.TrimSpace(e) == "" {
+			continue
+		}
+
+		k, v, _ := strings.Cut(e, "=")
+		pairs[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+
+	return pairs
+}
+
+// parseTargetFiles parses TARGET_FILES entries of the form "path" or "path=section"
+func parseTargetFiles(entries []string) []TargetFile {
+	var files []TargetFile
+	for _, e := range entries {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+
+		path, section, _ := strings.Cut(e, "=")
+		files = append(files, TargetFile{
+			Path:        strings.TrimSpace(path),
+			SectionName: strings.TrimSpace(section),
+		})
+	}
+
+	return files
 }
 
 // Validate validates the configuration and returns an error if any value is invalid
@@ -172,6 +368,13 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("GITHUB_TOKEN is required")
 	}
 
+	if c.WakaTimeAPIURL != "" {
+		u, err := url.Parse(c.WakaTimeAPIURL)
+		if err != nil || u.Host == "" || u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("WAKATIME_API_URL must be an absolute http or https URL, such as https://wakapi.example.com/api/compat/wakatime/v1")
+		}
+	}
+
 	if c.WakaTimeAPIKey != "" && c.WakaTimeRange != "" {
 		if !wakatime.StatsRange(c.WakaTimeRange).IsValid() {
 			validRanges := []string{
@@ -191,6 +394,10 @@ func (c *Config) Validate() error {
 			WakaDataLanguages,
 			WakaDataProjects,
 			WakaDataOperatingSystems,
+			WakaDataCategories,
+			WakaDataMachines,
+			WakaDataDependencies,
+			WakaDataBranches,
 		}
 		for _, data := range c.WakaTimeData {
 			trimmed := strings.TrimSpace(data)
@@ -212,6 +419,18 @@ func (c *Config) Validate() error {
 		MetricWakaTimeSpentTime,
 		MetricCodingStreak,
 		MetricWakaTimeAIStats,
+		MetricCommitCalendar,
+		MetricCommitsPerYear,
+		MetricCommitsPerQuarter,
+		MetricCodeChurn,
+		MetricCommitHours,
+		MetricCommitPunchCard,
+		MetricProfileSummary,
+		MetricTopRepositories,
+		MetricCommitSizes,
+		MetricCommitTrend,
+		MetricWakaTimeDaily,
+		MetricWakaTimeGoals,
 	}
 	for _, metric := range c.ShowMetrics {
 		trimmed := strings.TrimSpace(metric)
@@ -220,13 +439,268 @@ func (c *Config) Validate() error {
 		}
 	}
 
-	if c.ProgressBarVersion != "" && c.ProgressBarVersion != ProgressBarVersion1 && c.ProgressBarVersion != ProgressBarVersion2 {
-		return fmt.Errorf("PROGRESS_BAR_VERSION must be '%s' or '%s'", ProgressBarVersion1, ProgressBarVersion2)
+	for name, def := range c.ProgressBarStyles {
+		if name == "" || writer.IsBuiltinProgressBarStyle(name) {
+			return fmt.Errorf("PROGRESS_BAR_STYLES: style names must be set and cannot reuse the built-in names %s, %s, %s", ProgressBarVersion1, ProgressBarVersion2, ProgressBarEighths)
+		}
+
+		if _, err := writer.ParseProgressBarStyle(def); err != nil {
+			return fmt.Errorf("PROGRESS_BAR_STYLES: style %s is invalid: %v", name, err)
+		}
+	}
+
+	if c.ProgressBarVersion != "" && !c.hasProgressBarStyle(c.ProgressBarVersion) {
+		return fmt.Errorf("PROGRESS_BAR_VERSION must be '%s', '%s', '%s' or a style defined in PROGRESS_BAR_STYLES", ProgressBarVersion1, ProgressBarVersion2, ProgressBarEighths)
+	}
+
+	for metric, style := range c.MetricProgressBars {
+		if !contains(validMetrics, metric) {
+			return fmt.Errorf("METRIC_PROGRESS_BARS contains invalid metric. Valid values: %s", strings.Join(validMetrics, ", "))
+		}
+
+		if !c.hasProgressBarStyle(style) {
+			return fmt.Errorf("METRIC_PROGRESS_BARS: style for %s must be '%s', '%s', '%s' or a style defined in PROGRESS_BAR_STYLES", metric, ProgressBarVersion1, ProgressBarVersion2, ProgressBarEighths)
+		}
+	}
+
+	if c.Locale != "" && !contains(writer.Locales(), c.Locale) {
+		return fmt.Errorf("LOCALE must be one of: %s", strings.Join(writer.Locales(), ", "))
+	}
+
+	if c.RenderStyle != "" && c.RenderStyle != RenderStyleText && c.RenderStyle != RenderStyleSVG && c.RenderStyle != RenderStyleTable {
+		return fmt.Errorf("RENDER_STYLE must be '%s', '%s' or '%s'", RenderStyleText, RenderStyleSVG, RenderStyleTable)
+	}
+
+	if c.HeadingLevel < 0 || c.HeadingLevel > 6 {
+		return fmt.Errorf("HEADING_LEVEL must be a number from 0 to 6")
+	}
+
+	for metric, level := range c.MetricHeadings {
+		if !contains(validMetrics, metric) {
+			return fmt.Errorf("METRIC_HEADINGS contains invalid metric. Valid values: %s", strings.Join(validMetrics, ", "))
+		}
+
+		if n := headingLevel(level); n < 0 || n > 6 {
+			return fmt.Errorf("METRIC_HEADINGS: level for %s must be a number from 0 to 6", metric)
+		}
+	}
+
+	for metric := range c.MetricDetails {
+		if !contains(validMetrics, metric) {
+			return fmt.Errorf("METRIC_DETAILS contains invalid metric. Valid values: %s", strings.Join(validMetrics, ", "))
+		}
+	}
+
+	if _, err := c.DayPeriods(); err != nil {
+		return fmt.Errorf("COMMIT_TIME_PERIODS is invalid: %v", err)
+	}
+
+	if c.CommitsPerYearLimit < 0 {
+		return fmt.Errorf("COMMITS_PER_YEAR_LIMIT must be a non-negative number")
+	}
+
+	if c.CommitsPerQuarterLimit < 0 {
+		return fmt.Errorf("COMMITS_PER_QUARTER_LIMIT must be a non-negative number")
+	}
+
+	if c.CodeChurnPeriod != "" && c.CodeChurnPeriod != ChurnPeriodYear && c.CodeChurnPeriod != ChurnPeriodMonth {
+		return fmt.Errorf("CODE_CHURN_PERIOD must be '%s' or '%s'", ChurnPeriodYear, ChurnPeriodMonth)
+	}
+
+	if c.TopRepositoriesLimit < 0 {
+		return fmt.Errorf("TOP_REPOSITORIES_LIMIT must be a positive number")
+	}
+
+	if c.CommitTrendPeriod != "" && c.CommitTrendPeriod != TrendPeriodMonth && c.CommitTrendPeriod != TrendPeriodWeek {
+		return fmt.Errorf("COMMIT_TREND_PERIOD must be '%s' or '%s'", TrendPeriodMonth, TrendPeriodWeek)
+	}
+
+	if c.CommitTrendWindow < 0 {
+		return fmt.Errorf("COMMIT_TREND_WINDOW must be a positive number")
+	}
+
+	if c.TopStreaks < 0 {
+		return fmt.Errorf("TOP_STREAKS must be a positive number")
+	}
+
+	if c.WakaTimeDailyDays < 0 {
+		return fmt.Errorf("WAKATIME_DAILY_DAYS must be a positive number")
+	}
+
+	if c.WakaTimeWaitSeconds < 0 {
+		return fmt.Errorf("WAKATIME_WAIT_SECONDS must be a non-negative number")
+	}
+
+	chartMetrics := []string{
+		MetricCommitDaysOfWeek,
+		MetricCommitTimesOfDay,
+		MetricLanguagePerRepo,
+		MetricCommitsPerYear,
+		MetricCommitsPerQuarter,
+		MetricCommitHours,
+		MetricTopRepositories,
+		MetricCommitSizes,
+		MetricCommitTrend,
+		MetricWakaTimeDaily,
+	}
+	for metric, style := range c.MetricStyles {
+		if !contains(validMetrics, metric) {
+			return fmt.Errorf("METRIC_STYLES contains invalid metric. Valid values: %s", strings.Join(validMetrics, ", "))
+		}
+
+		switch style {
+		case RenderStyleText, RenderStyleSVG, RenderStyleTable:
+		case RenderStylePie, RenderStyleXYChart:
+			if !contains(chartMetrics, metric) {
+				return fmt.Errorf("METRIC_STYLES: %s only supports '%s', '%s' and '%s'; charts are available for %s", metric, RenderStyleText, RenderStyleSVG, RenderStyleTable, strings.Join(chartMetrics, ", "))
+			}
+		default:
+			return fmt.Errorf("METRIC_STYLES: style for %s must be one of: %s, %s, %s, %s, %s", metric, RenderStyleText, RenderStyleSVG, RenderStyleTable, RenderStylePie, RenderStyleXYChart)
+		}
+	}
+
+	if c.SVGTheme != "" && !contains([]string{writer.SVGThemeAuto, writer.SVGThemeLight, writer.SVGThemeDark}, c.SVGTheme) {
+		return fmt.Errorf("SVG_THEME must be one of: %s, %s, %s", writer.SVGThemeAuto, writer.SVGThemeLight, writer.SVGThemeDark)
+	}
+
+	if !isInsideRepo(c.SVGDir) {
+		return fmt.Errorf("SVG_DIR must be a relative path inside the repository")
+	}
+
+	if !isInsideRepo(c.JSONOutputFile) {
+		return fmt.Errorf("JSON_OUTPUT_FILE must be a relative path inside the repository")
+	}
+
+	if c.JSONOutputFile != "" {
+		out := filepath.Clean(c.JSONOutputFile)
+		if c.CacheFile != "" && out == repoPath(c.CacheFile) {
+			return fmt.Errorf("JSON_OUTPUT_FILE must not be the CACHE_FILE")
+		}
+
+		if dir := filepath.Clean(c.SVGDir); dir != "." && strings.HasPrefix(out, dir+string(filepath.Separator)) {
+			return fmt.Errorf("JSON_OUTPUT_FILE must not be inside SVG_DIR")
+		}
+	}
+
+	seen := make(map[string]bool, len(c.TargetFiles))
+	for _, f := range c.TargetFiles {
+		if f.Path == "" || !isInsideRepo(f.Path) {
+			return fmt.Errorf("TARGET_FILES entries must be relative paths inside the repository, got %q", f.Path)
+		}
+
+		clean := filepath.Clean(f.Path)
+		if seen[clean] {
+			return fmt.Errorf("TARGET_FILES lists %s more than once", f.Path)
+		}
+		if c.JSONOutputFile != "" && clean == filepath.Clean(c.JSONOutputFile) {
+			return fmt.Errorf("TARGET_FILES lists %s, which is also the JSON_OUTPUT_FILE", f.Path)
+		}
+		seen[clean] = true
+	}
+
+	if c.TemplateFile != "" {
+		if _, err := os.Stat(c.TemplateFile); err != nil {
+			return fmt.Errorf("TEMPLATE_FILE could not be read: %v", err)
+		}
 	}
 
 	return nil
 }
 
+// DayPeriods returns the COMMIT_TIME_PERIODS periods, or nil for the built-in ones
+func (c *Config) DayPeriods() ([]writer.DayPeriod, error) {
+	if strings.TrimSpace(c.CommitTimePeriods) == "" {
+		return nil, nil
+	}
+
+	return writer.ParseDayPeriods(c.CommitTimePeriods)
+}
+
+// LayoutFor returns how a metric is presented: its METRIC_HEADINGS entry or HEADING_LEVEL,
+// and whether METRIC_DETAILS collapses it
+func (c *Config) LayoutFor(metric string) writer.Layout {
+	l := writer.Layout{Heading: c.HeadingLevel}
+	if level, ok := c.MetricHeadings[metric]; ok {
+		l.Heading = headingLevel(level)
+	}
+
+	l.Summary, l.Details = c.MetricDetails[metric]
+
+	return l
+}
+
+// ShowsMetric reports whether SHOW_METRICS lists a metric
+func (c *Config) ShowsMetric(metric string) bool {
+	return contains(c.ShowMetrics, metric)
+}
+
+// BarStyleFor returns the progress bar style of a metric: its METRIC_PROGRESS_BARS entry, or PROGRESS_BAR_VERSION
+func (c *Config) BarStyleFor(metric string) string {
+	if style, ok := c.MetricProgressBars[metric]; ok {
+		return style
+	}
+
+	return c.ProgressBarVersion
+}
+
+// RegisterProgressBarStyles makes the PROGRESS_BAR_STYLES definitions available to the writer
+func (c *Config) RegisterProgressBarStyles() error {
+	for name, def := range c.ProgressBarStyles {
+		s, err := writer.ParseProgressBarStyle(def)
+		if err != nil {
+			return fmt.Errorf("PROGRESS_BAR_STYLES: style %s is invalid: %v", name, err)
+		}
+
+		if err := writer.RegisterProgressBarStyle(name, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) hasProgressBarStyle(name string) bool {
+	_, defined := c.ProgressBarStyles[name]
+	return defined || writer.IsBuiltinProgressBarStyle(name)
+}
+
+// StyleFor returns the render style of a metric: its METRIC_STYLES entry, or RENDER_STYLE
+func (c *Config) StyleFor(metric string) string {
+	if style, ok := c.MetricStyles[metric]; ok {
+		return style
+	}
+
+	return c.RenderStyle
+}
+
+// headingLevel parses a METRIC_HEADINGS level; values that are not a number return -1
+func headingLevel(value string) int {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return -1
+	}
+
+	return n
+}
+
+// isInsideRepo reports whether path is relative and does not escape the working directory
+func isInsideRepo(path string) bool {
+	clean := filepath.Clean(path)
+
+	return !filepath.IsAbs(clean) && clean != ".." && !strings.HasPrefix(clean, "../")
+}
+
+// repoPath returns path relative to GITHUB_WORKSPACE when it was anchored there, such as CACHE_FILE
+func repoPath(path string) string {
+	if ws := os.Getenv("GITHUB_WORKSPACE"); ws != "" && filepath.IsAbs(path) {
+		if rel, err := filepath.Rel(ws, path); err == nil {
+			return rel
+		}
+	}
+
+	return filepath.Clean(path)
+}
+
 // contains checks if a string slice contains a specific string
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
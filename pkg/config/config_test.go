@@ -4,6 +4,8 @@ import (
 	"os"
 	"strings"
 	"testing"
+
+	"github.com/thanhhaudev/github-stats/pkg/writer"
 )
 
 func TestLoad_SimpleLogs(t *testing.T) {
@@ -30,6 +32,132 @@ func TestLoad_EnableGitHubGroups(t *testing.T) {
 	}
 }
 
+func TestLoad_TargetFiles(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "ghp_test123")
+	t.Setenv("SHOW_METRICS", "COMMIT_TIMES_OF_DAY")
+	t.Setenv("SECTION_NAME", "stats")
+	t.Setenv("TARGET_FILES", "README.md, docs/profile.md=profile,index.md")
+
+	cfg := Load()
+
+	want := []TargetFile{
+		{Path: "README.md", SectionName: "stats"},
+		{Path: "docs/profile.md", SectionName: "profile"},
+		{Path: "index.md", SectionName: "stats"},
+	}
+	if len(cfg.TargetFiles) != len(want) {
+		t.Fatalf("expected %d target files, got %+v", len(want), cfg.TargetFiles)
+	}
+	for i, f := range want {
+		if cfg.TargetFiles[i] != f {
+			t.Errorf("target file %d = %+v, want %+v", i, cfg.TargetFiles[i], f)
+		}
+	}
+}
+
+func TestLoad_TargetFilesDefaultsToReadme(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "ghp_test123")
+	t.Setenv("SHOW_METRICS", "COMMIT_TIMES_OF_DAY")
+
+	cfg := Load()
+
+	if len(cfg.TargetFiles) != 1 || cfg.TargetFiles[0] != (TargetFile{Path: "README.md", SectionName: "readme-stats"}) {
+		t.Fatalf("expected README.md with the default section, got %+v", cfg.TargetFiles)
+	}
+}
+
+func TestLoad_MetricStyles(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "ghp_test123")
+	t.Setenv("SHOW_METRICS", "COMMIT_TIMES_OF_DAY")
+	t.Setenv("RENDER_STYLE", "svg")
+	t.Setenv("METRIC_STYLES", "COMMIT_DAYS_OF_WEEK=pie, LANGUAGE_PER_REPO = xychart")
+
+	cfg := Load()
+
+	for metric, want := range map[string]string{
+		"COMMIT_DAYS_OF_WEEK": "pie",
+		"LANGUAGE_PER_REPO":   "xychart",
+		"CODING_STREAK":       "svg",
+	} {
+		if got := cfg.StyleFor(metric); got != want {
+			t.Errorf("StyleFor(%s) = %q, want %q", metric, got, want)
+		}
+	}
+}
+
+func TestLoad_CommitsPerPeriodLimits(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "ghp_test123")
+	t.Setenv("SHOW_METRICS", "COMMITS_PER_YEAR")
+	t.Setenv("COMMITS_PER_YEAR_LIMIT", "5")
+	t.Setenv("COMMITS_PER_QUARTER_LIMIT", "four")
+
+	cfg := Load()
+
+	if cfg.CommitsPerYearLimit != 5 {
+		t.Errorf("expected COMMITS_PER_YEAR_LIMIT 5, got %d", cfg.CommitsPerYearLimit)
+	}
+	if cfg.TopRepositoriesLimit != 5 {
+		t.Errorf("expected default TOP_REPOSITORIES_LIMIT 5, got %d", cfg.TopRepositoriesLimit)
+	}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "COMMITS_PER_QUARTER_LIMIT") {
+		t.Errorf("expected an error for a non-numeric COMMITS_PER_QUARTER_LIMIT, got %v", err)
+	}
+}
+
+func TestLoad_ProgressBarStyles(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "ghp_test123")
+	t.Setenv("SHOW_METRICS", "COMMIT_TIMES_OF_DAY")
+	t.Setenv("PROGRESS_BAR_VERSION", "2")
+	t.Setenv("PROGRESS_BAR_STYLES", "thin=▰|▱|20;dots=●|○|10")
+	t.Setenv("METRIC_PROGRESS_BARS", "COMMIT_TIMES_OF_DAY=thin")
+
+	cfg := Load()
+
+	if cfg.ProgressBarStyles["thin"] != "▰|▱|20" || cfg.ProgressBarStyles["dots"] != "●|○|10" {
+		t.Fatalf("unexpected PROGRESS_BAR_STYLES: %v", cfg.ProgressBarStyles)
+	}
+	if got := cfg.BarStyleFor("COMMIT_TIMES_OF_DAY"); got != "thin" {
+		t.Errorf("BarStyleFor(COMMIT_TIMES_OF_DAY) = %q, want thin", got)
+	}
+	if got := cfg.BarStyleFor("COMMIT_DAYS_OF_WEEK"); got != "2" {
+		t.Errorf("BarStyleFor(COMMIT_DAYS_OF_WEEK) = %q, want 2", got)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	if err := cfg.RegisterProgressBarStyles(); err != nil {
+		t.Fatalf("RegisterProgressBarStyles returned error: %v", err)
+	}
+}
+
+func TestLoad_MetricLayouts(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "ghp_test123")
+	t.Setenv("SHOW_METRICS", "WAKATIME_SPENT_TIME,CODING_STREAK,CODE_CHURN")
+	t.Setenv("HEADING_LEVEL", "2")
+	t.Setenv("METRIC_HEADINGS", "CODING_STREAK=0")
+	t.Setenv("METRIC_DETAILS", "WAKATIME_SPENT_TIME=Coding time, by category: {title};CODE_CHURN")
+
+	cfg := Load()
+
+	tests := []struct {
+		metric string
+		want   writer.Layout
+	}{
+		{metric: "WAKATIME_SPENT_TIME", want: writer.Layout{Heading: 2, Details: true, Summary: "Coding time, by category: {title}"}},
+		{metric: "CODING_STREAK", want: writer.Layout{}},
+		{metric: "CODE_CHURN", want: writer.Layout{Heading: 2, Details: true}},
+	}
+
+	for _, tt := range tests {
+		if got := cfg.LayoutFor(tt.metric); got != tt.want {
+			t.Errorf("LayoutFor(%s) = %+v, want %+v", tt.metric, got, tt.want)
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+}
+
 func TestConfig_Validate(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -84,6 +212,37 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "WAKATIME_RANGE must be one of",
 		},
+		{
+			name: "valid WAKATIME_DATA breakdowns",
+			config: &Config{
+				GitHubToken:    "ghp_test123",
+				WakaTimeAPIKey: "waka_test123",
+				WakaTimeData:   []string{"CATEGORIES", "MACHINES", "DEPENDENCIES", "BRANCHES"},
+				ShowMetrics:    []string{"WAKATIME_SPENT_TIME"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid WAKATIME_API_URL",
+			config: &Config{
+				GitHubToken:    "ghp_test123",
+				WakaTimeAPIKey: "waka_test123",
+				WakaTimeAPIURL: "https://wakapi.example.com/api/compat/wakatime/v1",
+				ShowMetrics:    []string{"WAKATIME_SPENT_TIME"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "relative WAKATIME_API_URL",
+			config: &Config{
+				GitHubToken:    "ghp_test123",
+				WakaTimeAPIKey: "waka_test123",
+				WakaTimeAPIURL: "wakapi.example.com/api",
+				ShowMetrics:    []string{"WAKATIME_SPENT_TIME"},
+			},
+			wantErr: true,
+			errMsg:  "WAKATIME_API_URL must be an absolute http or https URL",
+		},
 		{
 			name: "invalid WAKATIME_DATA",
 			config: &Config{
@@ -121,7 +280,294 @@ func TestConfig_Validate(t *testing.T) {
 				ProgressBarVersion: "3",
 			},
 			wantErr: true,
-			errMsg:  "PROGRESS_BAR_VERSION must be '1' or '2'",
+			errMsg:  "PROGRESS_BAR_VERSION must be '1', '2', 'eighths' or a style defined in PROGRESS_BAR_STYLES",
+		},
+		{
+			name: "invalid RENDER_STYLE",
+			config: &Config{
+				GitHubToken: "ghp_test123",
+				ShowMetrics: []string{"COMMIT_TIMES_OF_DAY"},
+				RenderStyle: "png",
+			},
+			wantErr: true,
+			errMsg:  "RENDER_STYLE must be",
+		},
+		{
+			name: "invalid SVG_THEME",
+			config: &Config{
+				GitHubToken: "ghp_test123",
+				ShowMetrics: []string{"COMMIT_TIMES_OF_DAY"},
+				RenderStyle: "svg",
+				SVGTheme:    "neon",
+			},
+			wantErr: true,
+			errMsg:  "SVG_THEME must be one of",
+		},
+		{
+			name: "SVG_DIR outside the repository",
+			config: &Config{
+				GitHubToken: "ghp_test123",
+				ShowMetrics: []string{"COMMIT_TIMES_OF_DAY"},
+				SVGDir:      "../elsewhere",
+			},
+			wantErr: true,
+			errMsg:  "SVG_DIR must be a relative path",
+		},
+		{
+			name: "JSON_OUTPUT_FILE outside the repository",
+			config: &Config{
+				GitHubToken:    "ghp_test123",
+				ShowMetrics:    []string{"COMMIT_TIMES_OF_DAY"},
+				JSONOutputFile: "/tmp/stats.json",
+			},
+			wantErr: true,
+			errMsg:  "JSON_OUTPUT_FILE must be a relative path",
+		},
+		{
+			name: "JSON_OUTPUT_FILE is a target file",
+			config: &Config{
+				GitHubToken:    "ghp_test123",
+				ShowMetrics:    []string{"COMMIT_TIMES_OF_DAY"},
+				JSONOutputFile: "./README.md",
+				TargetFiles:    []TargetFile{{Path: "README.md"}},
+			},
+			wantErr: true,
+			errMsg:  "TARGET_FILES lists README.md, which is also the JSON_OUTPUT_FILE",
+		},
+		{
+			name: "JSON_OUTPUT_FILE is the cache file",
+			config: &Config{
+				GitHubToken:    "ghp_test123",
+				ShowMetrics:    []string{"COMMIT_TIMES_OF_DAY"},
+				JSONOutputFile: ".github-stats-cache.json",
+				CacheFile:      ".github-stats-cache.json",
+			},
+			wantErr: true,
+			errMsg:  "JSON_OUTPUT_FILE must not be the CACHE_FILE",
+		},
+		{
+			name: "JSON_OUTPUT_FILE inside SVG_DIR",
+			config: &Config{
+				GitHubToken:    "ghp_test123",
+				ShowMetrics:    []string{"COMMIT_TIMES_OF_DAY"},
+				JSONOutputFile: "assets/stats.json",
+				SVGDir:         "assets/",
+			},
+			wantErr: true,
+			errMsg:  "JSON_OUTPUT_FILE must not be inside SVG_DIR",
+		},
+		{
+			name: "TARGET_FILES outside the repository",
+			config: &Config{
+				GitHubToken: "ghp_test123",
+				ShowMetrics: []string{"COMMIT_TIMES_OF_DAY"},
+				TargetFiles: []TargetFile{{Path: "README.md"}, {Path: "../other/README.md"}},
+			},
+			wantErr: true,
+			errMsg:  "TARGET_FILES entries must be relative paths",
+		},
+		{
+			name: "TARGET_FILES with a duplicate path",
+			config: &Config{
+				GitHubToken: "ghp_test123",
+				ShowMetrics: []string{"COMMIT_TIMES_OF_DAY"},
+				TargetFiles: []TargetFile{{Path: "README.md"}, {Path: "./README.md", SectionName: "other"}},
+			},
+			wantErr: true,
+			errMsg:  "TARGET_FILES lists ./README.md more than once",
+		},
+		{
+			name: "valid METRIC_STYLES",
+			config: &Config{
+				GitHubToken: "ghp_test123",
+				ShowMetrics: []string{"COMMIT_TIMES_OF_DAY"},
+				MetricStyles: map[string]string{
+					"COMMIT_DAYS_OF_WEEK": "pie",
+					"LANGUAGE_PER_REPO":   "xychart",
+					"CODING_STREAK":       "svg",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "METRIC_STYLES chart for a metric without counts",
+			config: &Config{
+				GitHubToken:  "ghp_test123",
+				ShowMetrics:  []string{"COMMIT_TIMES_OF_DAY"},
+				MetricStyles: map[string]string{"CODING_STREAK": "pie"},
+			},
+			wantErr: true,
+			errMsg:  "CODING_STREAK only supports",
+		},
+		{
+			name: "METRIC_STYLES unknown style",
+			config: &Config{
+				GitHubToken:  "ghp_test123",
+				ShowMetrics:  []string{"COMMIT_TIMES_OF_DAY"},
+				MetricStyles: map[string]string{"COMMIT_TIMES_OF_DAY": "donut"},
+			},
+			wantErr: true,
+			errMsg:  "style for COMMIT_TIMES_OF_DAY must be one of",
+		},
+		{
+			name: "METRIC_STYLES unknown metric",
+			config: &Config{
+				GitHubToken:  "ghp_test123",
+				ShowMetrics:  []string{"COMMIT_TIMES_OF_DAY"},
+				MetricStyles: map[string]string{"COMMITS": "pie"},
+			},
+			wantErr: true,
+			errMsg:  "METRIC_STYLES contains invalid metric",
+		},
+		{
+			name: "invalid COMMITS_PER_QUARTER_LIMIT",
+			config: &Config{
+				GitHubToken:            "ghp_test123",
+				ShowMetrics:            []string{"COMMITS_PER_QUARTER"},
+				CommitsPerQuarterLimit: -1,
+			},
+			wantErr: true,
+			errMsg:  "COMMITS_PER_QUARTER_LIMIT must be a non-negative number",
+		},
+		{
+			name: "invalid CODE_CHURN_PERIOD",
+			config: &Config{
+				GitHubToken:     "ghp_test123",
+				ShowMetrics:     []string{"CODE_CHURN"},
+				CodeChurnPeriod: "week",
+			},
+			wantErr: true,
+			errMsg:  "CODE_CHURN_PERIOD must be 'year' or 'month'",
+		},
+		{
+			name: "invalid COMMIT_TREND_PERIOD",
+			config: &Config{
+				GitHubToken:       "ghp_test123",
+				ShowMetrics:       []string{"COMMIT_TREND"},
+				CommitTrendPeriod: "day",
+			},
+			wantErr: true,
+			errMsg:  "COMMIT_TREND_PERIOD must be 'month' or 'week'",
+		},
+		{
+			name: "invalid HEADING_LEVEL",
+			config: &Config{
+				GitHubToken:  "ghp_test123",
+				ShowMetrics:  []string{"CODING_STREAK"},
+				HeadingLevel: 7,
+			},
+			wantErr: true,
+			errMsg:  "HEADING_LEVEL must be a number from 0 to 6",
+		},
+		{
+			name: "invalid METRIC_HEADINGS level",
+			config: &Config{
+				GitHubToken:    "ghp_test123",
+				ShowMetrics:    []string{"CODING_STREAK"},
+				MetricHeadings: map[string]string{"CODING_STREAK": "h2"},
+			},
+			wantErr: true,
+			errMsg:  "METRIC_HEADINGS: level for CODING_STREAK must be a number from 0 to 6",
+		},
+		{
+			name: "METRIC_DETAILS unknown metric",
+			config: &Config{
+				GitHubToken:   "ghp_test123",
+				ShowMetrics:   []string{"CODING_STREAK"},
+				MetricDetails: map[string]string{"STREAK": ""},
+			},
+			wantErr: true,
+			errMsg:  "METRIC_DETAILS contains invalid metric",
+		},
+		{
+			name: "table RENDER_STYLE with a per-metric override",
+			config: &Config{
+				GitHubToken:  "ghp_test123",
+				ShowMetrics:  []string{"CODING_STREAK", "COMMIT_DAYS_OF_WEEK"},
+				RenderStyle:  "table",
+				MetricStyles: map[string]string{"CODING_STREAK": "table", "COMMIT_DAYS_OF_WEEK": "pie"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid COMMIT_TIME_PERIODS",
+			config: &Config{
+				GitHubToken:       "ghp_test123",
+				ShowMetrics:       []string{"COMMIT_TIMES_OF_DAY", "COMMIT_HOURS"},
+				CommitTimePeriods: "6-14|Early;12-6|Late",
+			},
+			wantErr: true,
+			errMsg:  "COMMIT_TIME_PERIODS is invalid: period \"12-6|Late\" overlaps another period at 12:00",
+		},
+		{
+			name: "invalid LOCALE",
+			config: &Config{
+				GitHubToken: "ghp_test123",
+				ShowMetrics: []string{"COMMIT_DAYS_OF_WEEK"},
+				Locale:      "fr",
+			},
+			wantErr: true,
+			errMsg:  "LOCALE must be one of: en, ja, vi",
+		},
+		{
+			name: "supported LOCALE",
+			config: &Config{
+				GitHubToken: "ghp_test123",
+				ShowMetrics: []string{"COMMIT_DAYS_OF_WEEK"},
+				Locale:      "vi",
+			},
+			wantErr: false,
+		},
+		{
+			name: "custom progress bar styles",
+			config: &Config{
+				GitHubToken:        "ghp_test123",
+				ShowMetrics:        []string{"COMMIT_TIMES_OF_DAY"},
+				ProgressBarVersion: "thin",
+				ProgressBarStyles:  map[string]string{"thin": "▰|▱|20"},
+				MetricProgressBars: map[string]string{"COMMIT_TIMES_OF_DAY": "eighths"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PROGRESS_BAR_STYLES redefines a built-in style",
+			config: &Config{
+				GitHubToken:       "ghp_test123",
+				ShowMetrics:       []string{"COMMIT_TIMES_OF_DAY"},
+				ProgressBarStyles: map[string]string{"2": "▰|▱"},
+			},
+			wantErr: true,
+			errMsg:  "cannot reuse the built-in names",
+		},
+		{
+			name: "PROGRESS_BAR_STYLES invalid definition",
+			config: &Config{
+				GitHubToken:       "ghp_test123",
+				ShowMetrics:       []string{"COMMIT_TIMES_OF_DAY"},
+				ProgressBarStyles: map[string]string{"wide": "▰|▱|500"},
+			},
+			wantErr: true,
+			errMsg:  "style wide is invalid: width must be between 1 and 100",
+		},
+		{
+			name: "METRIC_PROGRESS_BARS unknown style",
+			config: &Config{
+				GitHubToken:        "ghp_test123",
+				ShowMetrics:        []string{"COMMIT_TIMES_OF_DAY"},
+				MetricProgressBars: map[string]string{"COMMIT_TIMES_OF_DAY": "thin"},
+			},
+			wantErr: true,
+			errMsg:  "METRIC_PROGRESS_BARS: style for COMMIT_TIMES_OF_DAY",
+		},
+		{
+			name: "missing TEMPLATE_FILE",
+			config: &Config{
+				GitHubToken:  "ghp_test123",
+				ShowMetrics:  []string{"COMMIT_TIMES_OF_DAY"},
+				TemplateFile: "does-not-exist.tmpl",
+			},
+			wantErr: true,
+			errMsg:  "TEMPLATE_FILE could not be read",
 		},
 		{
 			name: "valid WAKATIME_RANGE - last_30_days",
@@ -178,14 +624,28 @@ func TestPublicEnvKeysAreDocumentedAndExposedByAction(t *testing.T) {
 	publicEnvKeys := []string{
 		"GITHUB_TOKEN",
 		"WAKATIME_API_KEY",
+		"WAKATIME_API_URL",
 		"WAKATIME_RANGE",
 		"WAKATIME_DATA",
+		"WAKATIME_DAILY_DAYS",
+		"WAKATIME_WAIT_SECONDS",
 		"SHOW_METRICS",
 		"SHOW_LAST_UPDATE",
 		"TIME_LAYOUT",
 		"TIME_ZONE",
+		"LOCALE",
 		"PROGRESS_BAR_VERSION",
+		"PROGRESS_BAR_STYLES",
+		"METRIC_PROGRESS_BARS",
 		"SIMPLIFY_COMMIT_TIMES_TITLE",
+		"COMMIT_TIME_PERIODS",
+		"COMMITS_PER_YEAR_LIMIT",
+		"COMMITS_PER_QUARTER_LIMIT",
+		"CODE_CHURN_PERIOD",
+		"TOP_REPOSITORIES_LIMIT",
+		"COMMIT_TREND_PERIOD",
+		"COMMIT_TREND_WINDOW",
+		"TOP_STREAKS",
 		"DRY_RUN",
 		"DEBUG",
 		"SIMPLE_LOGS",
@@ -194,11 +654,21 @@ func TestPublicEnvKeysAreDocumentedAndExposedByAction(t *testing.T) {
 		"COMMIT_MESSAGE",
 		"BRANCH_NAME",
 		"SECTION_NAME",
+		"TARGET_FILES",
 		"HIDE_REPO_INFO",
 		"EXCLUDE_FORK_REPOS",
 		"ONLY_MAIN_BRANCH",
 		"ENABLE_CACHE",
 		"CACHE_FILE",
+		"RENDER_STYLE",
+		"METRIC_STYLES",
+		"HEADING_LEVEL",
+		"METRIC_HEADINGS",
+		"METRIC_DETAILS",
+		"SVG_THEME",
+		"SVG_DIR",
+		"JSON_OUTPUT_FILE",
+		"TEMPLATE_FILE",
 	}
 
 	for _, key := range publicEnvKeys {
@@ -226,6 +696,18 @@ func TestMetricKeysAreDocumented(t *testing.T) {
 		MetricWakaTimeSpentTime,
 		MetricCodingStreak,
 		MetricWakaTimeAIStats,
+		MetricCommitCalendar,
+		MetricCommitsPerYear,
+		MetricCommitsPerQuarter,
+		MetricCodeChurn,
+		MetricCommitHours,
+		MetricCommitPunchCard,
+		MetricProfileSummary,
+		MetricTopRepositories,
+		MetricCommitSizes,
+		MetricCommitTrend,
+		MetricWakaTimeDaily,
+		MetricWakaTimeGoals,
 	}
 
 	for _, key := range metricKeys {
@@ -2,10 +2,13 @@ package container
 
 import (
 	"fmt"
+	"math"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/thanhhaudev/github-stats/pkg/github"
+	"github.com/thanhhaudev/github-stats/pkg/writer"
 )
 
 // CommitStats stores the calculated commit data
@@ -16,6 +19,18 @@ type CommitStats struct {
 	QuarterlyCommits map[string]int
 	CurrentStreak    int
 	LongestStreak    int
+	Streaks          []writer.Streak // every streak, longest first
+	LongestGap       writer.Streak   // longest run of days without commits between two commits
+	ActiveDays       int             // days with at least one commit
+	FirstCommit      time.Time       // zero when there are no commits
+	LastCommit       time.Time       // zero when there are no commits
+}
+
+// ProfileStats stores the account details shown by PROFILE_SUMMARY
+type ProfileStats struct {
+	CreatedAt        time.Time // zero when GitHub did not return it
+	OwnedRepos       int
+	ContributedRepos int
 }
 
 // LanguageStats stores the calculated language data
@@ -67,14 +82,27 @@ func (d *DataContainer) CalculateAIStats() *AIStats {
 
 // CalculateCommits calculates the number of commits per year and per day of the week
 // return commits per year, commits per day of the week
-func (d *DataContainer) CalculateCommits() *CommitStats {
+func (d *DataContainer) CalculateCommits(now time.Time) *CommitStats {
 	yearlyCommits := make(map[int]int)
 	quarterlyCommits := make(map[string]int, 4)
 	dailyCommits := make(map[time.Weekday]int, 7)
 
-	var totalCommits int
+	var (
+		totalCommits int
+		firstCommit  time.Time
+		lastCommit   time.Time
+	)
+	activeDays := make(map[string]bool)
 	for _, commit := range d.Data.Commits {
 		commitDate := commit.CommittedDate
+		activeDays[commitDate.Format("2006-01-02")] = true
+		if firstCommit.IsZero() || commitDate.Before(firstCommit) {
+			firstCommit = commitDate
+		}
+		if commitDate.After(lastCommit) {
+			lastCommit = commitDate
+		}
+
 		year := commitDate.Year()
 		day := commitDate.Weekday()
 		month := commitDate.Month()
@@ -88,16 +116,46 @@ func (d *DataContainer) CalculateCommits() *CommitStats {
 	}
 
 	// Calculate streaks
-	currentStreak, longestStreak := calculateStreaks(d.Data.Commits)
+	current, streaks, longestGap := calculateStreaks(d.Data.Commits, now)
+	var longest int
+	if len(streaks) > 0 {
+		longest = streaks[0].Days
+	}
 
 	return &CommitStats{
 		TotalCommits:     totalCommits,
 		YearlyCommits:    yearlyCommits,
 		DailyCommits:     dailyCommits,
 		QuarterlyCommits: quarterlyCommits,
-		CurrentStreak:    currentStreak,
-		LongestStreak:    longestStreak,
+		CurrentStreak:    current.Days,
+		LongestStreak:    longest,
+		Streaks:          streaks,
+		LongestGap:       longestGap,
+		ActiveDays:       len(activeDays),
+		FirstCommit:      firstCommit,
+		LastCommit:       lastCommit,
+	}
+}
+
+// CalculateProfile splits the repositories into owned and contributed to,
+// and reads the account creation date of the viewer
+func (d *DataContainer) CalculateProfile() *ProfileStats {
+	p := &ProfileStats{}
+	var login string
+	if d.Data.Viewer != nil {
+		login = d.Data.Viewer.Login
+		p.CreatedAt, _ = time.Parse(time.RFC3339, d.Data.Viewer.CreatedAt) // zero when missing
 	}
+
+	for _, repo := range d.Data.Repositories {
+		if login != "" && strings.EqualFold(repo.Owner.Login, login) {
+			p.OwnedRepos++
+		} else {
+			p.ContributedRepos++
+		}
+	}
+
+	return p
 }
 
 // CalculateLanguages calculates the number of languages used in repositories on GitHub
@@ -136,11 +194,13 @@ func truncateToMidnight(t time.Time) time.Time {
 	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
 }
 
-// calculateStreaks calculates the current and longest commit streaks
-// A streak is defined as consecutive days with at least one commit
-func calculateStreaks(commits []github.Commit) (currentStreak, longestStreak int) {
+// calculateStreaks returns every commit streak, longest first and the most recent first
+// among equally long ones, the current streak and the longest gap between two commits.
+// A streak is defined as consecutive days with at least one commit; the current streak
+// must reach the day of now or the day before and is zero otherwise.
+func calculateStreaks(commits []github.Commit, now time.Time) (current writer.Streak, streaks []writer.Streak, longestGap writer.Streak) {
 	if len(commits) == 0 {
-		return 0, 0
+		return current, nil, longestGap
 	}
 
 	// get timezone from the first commit
@@ -162,75 +222,50 @@ func calculateStreaks(commits []github.Commit) (currentStreak, longestStreak int
 		dates = append(dates, date)
 	}
 
-	// sort dates in descending order (most recent first)
+	// sort dates in ascending order (oldest first)
 	sort.Slice(dates, func(i, j int) bool {
-		return dates[i].After(dates[j])
+		return dates[i].Before(dates[j])
 	})
 
-	if len(dates) == 0 {
-		return 0, 0
-	}
-
-	// calculate current streak from today backwards
-	now := time.Now().In(loc)
-	today := truncateToMidnight(now)
-	yesterday := today.AddDate(0, 0, -1)
-
-	// Check if there's a commit today or yesterday to start the streak
-	currentStreak = 0
-
-	// Start from the most recent commit date (already at midnight)
-	mostRecentDate := dates[0]
-	if mostRecentDate.Equal(today) || mostRecentDate.Equal(yesterday) {
-		expectedDate := mostRecentDate
-		currentStreak = 1 // Count the first day
-
-		for i := 1; i < len(dates); i++ {
-			currentDate := dates[i] // Already at midnight
-
-			// move to next expected date
-			nextExpectedDate := expectedDate.AddDate(0, 0, -1)
-
-			// check if this date is the next consecutive day
-			if currentDate.Equal(nextExpectedDate) {
-				currentStreak++
-				expectedDate = nextExpectedDate
-			} else {
-				break // gap in streak
-			}
+	// split the dates into streaks, measuring the gaps between them
+	s := writer.Streak{Start: dates[0], End: dates[0], Days: 1}
+	for _, date := range dates[1:] {
+		if date.Equal(s.End.AddDate(0, 0, 1)) {
+			s.End = date
+			s.Days++
+			continue
 		}
-	}
 
-	// calculate longest streak
-	longestStreak = 0
-	tempStreak := 1
+		gap := writer.Streak{Start: s.End.AddDate(0, 0, 1), End: date.AddDate(0, 0, -1)}
+		gap.Days = daysBetween(gap.Start, gap.End) + 1
+		if gap.Days > longestGap.Days {
+			longestGap = gap
+		}
 
-	for i := 0; i < len(dates)-1; i++ {
-		currentDate := dates[i] // Already at midnight
-		nextDate := dates[i+1]  // Already at midnight
+		streaks = append(streaks, s)
+		s = writer.Streak{Start: date, End: date, Days: 1}
+	}
+	streaks = append(streaks, s)
 
-		// Check if dates are consecutive (1 day apart)
-		daysDiff := int(currentDate.Sub(nextDate).Hours() / 24)
+	// the most recent streak is still running when it reaches today or yesterday
+	today := truncateToMidnight(now.In(loc))
+	if s.End.Equal(today) || s.End.Equal(today.AddDate(0, 0, -1)) {
+		current = s
+	}
 
-		if daysDiff == 1 {
-			tempStreak++
-		} else {
-			if tempStreak > longestStreak {
-				longestStreak = tempStreak
-			}
-			tempStreak = 1
+	sort.SliceStable(streaks, func(i, j int) bool {
+		if streaks[i].Days != streaks[j].Days {
+			return streaks[i].Days > streaks[j].Days
 		}
-	}
 
-	// check the last streak
-	if tempStreak > longestStreak {
-		longestStreak = tempStreak
-	}
+		return streaks[i].End.After(streaks[j].End)
+	})
 
-	// if current streak is longer than longest, update longest
-	if currentStreak > longestStreak {
-		longestStreak = currentStreak
-	}
+	return current, streaks, longestGap
+}
 
-	return currentStreak, longestStreak
+// daysBetween returns the number of calendar days from a to b, both at midnight,
+// rounding away the hour gained or lost on daylight saving changes
+func daysBetween(a, b time.Time) int {
+	return int(math.Round(b.Sub(a).Hours() / 24))
 }
@@ -92,7 +92,11 @@ func TestCalculateStreaks(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			current, longest := calculateStreaks(tt.commits)
+			streak, streaks, _ := calculateStreaks(tt.commits, time.Now())
+			current, longest := streak.Days, 0
+			if len(streaks) > 0 {
+				longest = streaks[0].Days
+			}
 
 			if current != tt.expectedCurrent {
 				t.Errorf("%s: Expected current streak %d, got %d", tt.description, tt.expectedCurrent, current)
@@ -105,6 +109,55 @@ func TestCalculateStreaks(t *testing.T) {
 	}
 }
 
+func TestCalculateStreakRecords(t *testing.T) {
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2025, month, d, 10, 0, 0, 0, time.UTC)
+	}
+	var commits []github.Commit
+	for _, date := range []time.Time{
+		day(1, 1), day(1, 2), day(1, 3), // 3 days
+		day(1, 10), day(1, 11), // 2 days, after a 6-day gap
+		day(3, 1), day(3, 2), day(3, 2), day(3, 3), // 3 days, after a 48-day gap
+		day(3, 5), // 1 day
+	} {
+		commits = append(commits, github.Commit{CommittedDate: date})
+	}
+
+	current, streaks, gap := calculateStreaks(commits, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	if current.Days != 0 {
+		t.Errorf("expected no current streak for old commits, got %+v", current)
+	}
+
+	want := []struct {
+		start, end time.Time
+		days       int
+	}{
+		{start: day(3, 1), end: day(3, 3), days: 3}, // the most recent of the longest first
+		{start: day(1, 1), end: day(1, 3), days: 3},
+		{start: day(1, 10), end: day(1, 11), days: 2},
+		{start: day(3, 5), end: day(3, 5), days: 1},
+	}
+	if len(streaks) != len(want) {
+		t.Fatalf("expected %d streaks, got %+v", len(want), streaks)
+	}
+	for i, w := range want {
+		s := streaks[i]
+		if s.Days != w.days || !s.Start.Equal(truncateToMidnight(w.start)) || !s.End.Equal(truncateToMidnight(w.end)) {
+			t.Errorf("streak %d: expected %d days from %s to %s, got %+v", i, w.days, w.start.Format("2006-01-02"), w.end.Format("2006-01-02"), s)
+		}
+	}
+
+	if gap.Days != 48 || !gap.Start.Equal(truncateToMidnight(day(1, 12))) || !gap.End.Equal(truncateToMidnight(day(2, 28))) {
+		t.Errorf("expected a 48-day gap from 2025-01-12 to 2025-02-28, got %+v", gap)
+	}
+
+	// the current streak follows the given time, not the wall clock
+	if current, _, _ := calculateStreaks(commits, time.Date(2025, 3, 6, 8, 0, 0, 0, time.UTC)); current.Days != 1 || !current.End.Equal(truncateToMidnight(day(3, 5))) {
+		t.Errorf("expected the 2025-03-05 streak to be current on 2025-03-06, got %+v", current)
+	}
+}
+
 func newAIStatsContainer(stats *wakatime.Stats) *DataContainer {
 	d := NewDataContainer(log.Default(), &ClientManager{}, &config.Config{})
 	if stats != nil {
@@ -119,6 +172,30 @@ func aiStats(setup func(s *wakatime.Stats)) *wakatime.Stats {
 	return s
 }
 
+func TestCalculateProfile(t *testing.T) {
+	d := &DataContainer{}
+	d.Data.Viewer = &github.Viewer{Login: "Alice", CreatedAt: "2019-03-14T08:00:00Z"}
+	d.Data.Repositories = make([]github.Repository, 3)
+	d.Data.Repositories[0].Owner.Login = "alice"
+	d.Data.Repositories[1].Owner.Login = "alice"
+	d.Data.Repositories[2].Owner.Login = "octo-org"
+	d.Data.Commits = []github.Commit{
+		{CommittedDate: time.Date(2026, 5, 19, 21, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 18, 9, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 18, 11, 0, 0, 0, time.UTC)},
+	}
+
+	p := d.CalculateProfile()
+	if !p.CreatedAt.Equal(time.Date(2019, 3, 14, 8, 0, 0, 0, time.UTC)) || p.OwnedRepos != 2 || p.ContributedRepos != 1 {
+		t.Errorf("unexpected profile: %+v", p)
+	}
+
+	com := d.CalculateCommits(time.Now())
+	if com.ActiveDays != 2 || !com.FirstCommit.Equal(d.Data.Commits[1].CommittedDate) {
+		t.Errorf("expected 2 active days from 2026-05-18, got %d from %s", com.ActiveDays, com.FirstCommit)
+	}
+}
+
 func TestCalculateAIStats(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"html"
 	"log"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
@@ -24,18 +28,29 @@ const (
 	commitPerQuery = 100
 )
 
+// WakaTime polling backoff: the first retry waits wakaPollInitialDelay, each further one twice
+// as long up to wakaPollMaxDelay, until WAKATIME_WAIT_SECONDS have passed
+const (
+	wakaPollInitialDelay = 5 * time.Second
+	wakaPollMaxDelay     = time.Minute
+)
+
 type DataContainer struct {
 	ClientManager dataClientManager
 	Logger        *log.Logger
 	Config        *config.Config
 	Clock         clock.Clock
 	Cache         *cache.Cache // nil when caching is disabled
+	Files         []OutputFile // generated by GetStats, written next to the target files
+	wakaDeadline  time.Time    // end of the WAKATIME_WAIT_SECONDS polling window, set by the first WakaTime request
 	Data          struct {
-		Viewer          *github.Viewer
-		Repositories    []github.Repository
-		Commits         []github.Commit
-		WakaTime        *wakatime.Stats
-		WakaTimeAllTime *wakatime.AllTimeSinceTodayStats
+		Viewer            *github.Viewer
+		Repositories      []github.Repository
+		Commits           []github.Commit
+		WakaTime          *wakatime.Stats
+		WakaTimeAllTime   *wakatime.AllTimeSinceTodayStats
+		WakaTimeSummaries *wakatime.Summaries
+		WakaTimeGoals     *wakatime.Goals
 	}
 }
 
@@ -50,64 +65,278 @@ type dataClientManager interface {
 	GetDefaultBranch(ctx context.Context, owner, name string) (*github.Branch, error)
 	GetWakaTimeStats(ctx context.Context) (*wakatime.Stats, error)
 	GetWakaTimeAllTimeSinceToday(ctx context.Context) (*wakatime.AllTimeSinceTodayStats, error)
+	GetWakaTimeSummaries(ctx context.Context, start, end time.Time) (*wakatime.Summaries, error)
+	GetWakaTimeGoals(ctx context.Context) (*wakatime.Goals, error)
+}
+
+// OutputFile is a file generated alongside the README section, e.g. an SVG card
+type OutputFile struct {
+	Path    string
+	Content []byte
+}
+
+// metric holds one SHOW_METRICS entry in every form the writers can render it
+type metric struct {
+	text string       // built-in Markdown block
+	card *writer.Card // renderer-neutral rows; nil when the metric has no data
+}
+
+// streakHistory returns the commit streaks with the TOP_STREAKS longest ones
+func (d *DataContainer) streakHistory(com *CommitStats) writer.StreakHistory {
+	return writer.StreakHistory{
+		Current:    com.CurrentStreak,
+		Longest:    com.LongestStreak,
+		Top:        com.Streaks[:min(len(com.Streaks), d.Config.TopStreaks)],
+		LongestGap: com.LongestGap,
+		LastCommit: com.LastCommit,
+	}
 }
 
 // metrics returns the metrics map
-func (d *DataContainer) metrics(com *CommitStats, lang *LanguageStats, ai *AIStats) map[string]string {
-	version := d.Config.ProgressBarVersion
-	aiBlock := ""
+func (d *DataContainer) metrics(now time.Time, com *CommitStats, lang *LanguageStats, ai *AIStats) map[string]metric {
+	bar := d.Config.BarStyleFor
+	periods, _ := d.Config.DayPeriods() // validated on startup
+	profile := d.CalculateProfile()
+	streaks := d.streakHistory(com)
+	aiBlock := metric{}
 	if ai != nil && ai.HasData {
-		aiBlock = writer.MakeAIStatsList(ai.AIAdditions, ai.HumanAdditions, ai.AIInputTokens, ai.AIOutputTokens, ai.AvgPromptLength, d.Config.WakaTimeRange)
-	}
-	return map[string]string{
-		config.MetricLanguagePerRepo:   writer.MakeLanguagePerRepoList(d.Data.Repositories, version),
-		config.MetricLanguagesAndTools: writer.MakeLanguageAndToolList(lang.Languages, lang.TotalSize),
-		config.MetricCommitDaysOfWeek:  writer.MakeCommitDaysOfWeekList(com.DailyCommits, com.TotalCommits, version),
-		config.MetricCommitTimesOfDay:  writer.MakeCommitTimesOfDayList(d.Data.Commits, d.Config.SimplifyCommitTimesTitle, version),
-		config.MetricWakaTimeSpentTime: writer.MakeWakaActivityList(
-			d.Data.WakaTime,
-			d.Config.WakaTimeData,
-			version,
-		),
-		config.MetricCodingStreak:    writer.MakeCodingStreakList(d.Data.WakaTimeAllTime, com.CurrentStreak, com.LongestStreak),
+		aiBlock = metric{
+			text: writer.MakeAIStatsList(ai.AIAdditions, ai.HumanAdditions, ai.AIInputTokens, ai.AIOutputTokens, ai.AvgPromptLength, d.Config.WakaTimeRange),
+			card: writer.AIStatsCard(ai.AIAdditions, ai.HumanAdditions, ai.AIInputTokens, ai.AIOutputTokens, ai.AvgPromptLength, d.Config.WakaTimeRange),
+		}
+	}
+	return map[string]metric{
+		config.MetricLanguagePerRepo: {
+			text: writer.MakeLanguagePerRepoList(d.Data.Repositories, bar(config.MetricLanguagePerRepo)),
+			card: writer.LanguagePerRepoCard(d.Data.Repositories),
+		},
+		config.MetricLanguagesAndTools: {
+			text: writer.MakeLanguageAndToolList(lang.Languages, lang.TotalSize),
+			card: writer.LanguageAndToolCard(lang.Languages, lang.TotalSize),
+		},
+		config.MetricCommitDaysOfWeek: {
+			text: writer.MakeCommitDaysOfWeekList(com.DailyCommits, com.TotalCommits, bar(config.MetricCommitDaysOfWeek)),
+			card: writer.CommitDaysOfWeekCard(com.DailyCommits, com.TotalCommits),
+		},
+		config.MetricCommitTimesOfDay: {
+			text: writer.MakeCommitTimesOfDayList(d.Data.Commits, periods, d.Config.SimplifyCommitTimesTitle, bar(config.MetricCommitTimesOfDay)),
+			card: writer.CommitTimesOfDayCard(d.Data.Commits, periods, d.Config.SimplifyCommitTimesTitle),
+		},
+		config.MetricCommitHours: {
+			text: writer.MakeCommitHoursList(d.Data.Commits, bar(config.MetricCommitHours)),
+			card: writer.CommitHoursCard(d.Data.Commits),
+		},
+		config.MetricWakaTimeSpentTime: {
+			text: writer.MakeWakaActivityList(d.Data.WakaTime, d.Config.WakaTimeData, bar(config.MetricWakaTimeSpentTime)),
+			card: writer.WakaActivityCard(d.Data.WakaTime, d.Config.WakaTimeData),
+		},
+		config.MetricCodingStreak: {
+			text: writer.MakeCodingStreakList(d.Data.WakaTimeAllTime, streaks, now),
+			card: writer.CodingStreakCard(d.Data.WakaTimeAllTime, streaks, now),
+		},
 		config.MetricWakaTimeAIStats: aiBlock,
+		config.MetricWakaTimeDaily: {
+			text: writer.MakeWakaDailyList(d.Data.WakaTimeSummaries, bar(config.MetricWakaTimeDaily)),
+			card: writer.WakaDailyCard(d.Data.WakaTimeSummaries),
+		},
+		config.MetricWakaTimeGoals: {
+			text: writer.MakeWakaGoalsList(d.Data.WakaTimeGoals, bar(config.MetricWakaTimeGoals)),
+			card: writer.WakaGoalsCard(d.Data.WakaTimeGoals),
+		},
+		config.MetricCommitsPerYear: {
+			text: writer.MakeCommitsPerYearList(com.YearlyCommits, d.Config.CommitsPerYearLimit, bar(config.MetricCommitsPerYear)),
+			card: writer.CommitsPerYearCard(com.YearlyCommits, d.Config.CommitsPerYearLimit),
+		},
+		config.MetricCommitsPerQuarter: {
+			text: writer.MakeCommitsPerQuarterList(com.QuarterlyCommits, d.Config.CommitsPerQuarterLimit, bar(config.MetricCommitsPerQuarter)),
+			card: writer.CommitsPerQuarterCard(com.QuarterlyCommits, d.Config.CommitsPerQuarterLimit),
+		},
+		config.MetricCodeChurn: {
+			text: writer.MakeCodeChurnList(d.Data.Commits, d.Config.CodeChurnPeriod == config.ChurnPeriodMonth, now, bar(config.MetricCodeChurn)),
+			card: writer.CodeChurnCard(d.Data.Commits, d.Config.CodeChurnPeriod == config.ChurnPeriodMonth, now),
+		},
+		config.MetricCommitCalendar: {
+			text: writer.MakeCommitCalendar(d.Data.Commits, now),
+			card: writer.CommitCalendarCard(d.Data.Commits, now),
+		},
+		config.MetricCommitPunchCard: {
+			text: writer.MakeCommitPunchCard(d.Data.Commits, now.Location()),
+			card: writer.PunchCardCard(d.Data.Commits, now.Location()),
+		},
+		config.MetricCommitSizes: {
+			text: writer.MakeCommitSizesList(d.Data.Commits, bar(config.MetricCommitSizes)),
+			card: writer.CommitSizesCard(d.Data.Commits),
+		},
+		config.MetricCommitTrend: {
+			text: writer.MakeCommitTrend(d.Data.Commits, d.Config.CommitTrendWindow, d.Config.CommitTrendPeriod == config.TrendPeriodWeek, now),
+			card: writer.CommitTrendCard(d.Data.Commits, d.Config.CommitTrendWindow, d.Config.CommitTrendPeriod == config.TrendPeriodWeek, now),
+		},
+		config.MetricTopRepositories: {
+			text: writer.MakeTopRepositoriesList(d.Data.Commits, d.Data.Repositories, d.Config.TopRepositoriesLimit, d.Config.HideRepoInfo, bar(config.MetricTopRepositories)),
+			card: writer.TopRepositoriesCard(d.Data.Commits, d.Data.Repositories, d.Config.TopRepositoriesLimit, d.Config.HideRepoInfo),
+		},
+		config.MetricProfileSummary: {
+			text: writer.MakeProfileSummaryList(profile.CreatedAt, com.FirstCommit, profile.OwnedRepos, profile.ContributedRepos, com.TotalCommits, com.ActiveDays, now),
+			card: writer.ProfileSummaryCard(profile.CreatedAt, com.FirstCommit, profile.OwnedRepos, profile.ContributedRepos, com.TotalCommits, com.ActiveDays, now),
+		},
 	}
 }
 
-// GetStats returns the statistics
-func (d *DataContainer) GetStats(c clock.Clock) string {
+// render returns the README output for a metric in its METRIC_STYLES or RENDER_STYLE style,
+// laid out with its heading and collapse settings.
+// SVG cards are queued in d.Files and embedded with an <img> tag.
+func (d *DataContainer) render(key string, m metric) string {
+	out := m.text
+	switch d.Config.StyleFor(key) {
+	case config.RenderStylePie:
+		out = writer.MakeMermaidPie(m.card)
+	case config.RenderStyleXYChart:
+		out = writer.MakeMermaidXYChart(m.card)
+	case config.RenderStyleTable:
+		out = writer.MakeMarkdownTable(m.card, d.Config.BarStyleFor(key))
+	case config.RenderStyleSVG:
+		if m.card != nil {
+			out = d.renderSVG(key, m.card)
+		}
+	}
+
+	if m.card == nil {
+		return out
+	}
+
+	return writer.ApplyLayout(out, m.card, d.Config.LayoutFor(key))
+}
+
+// renderSVG queues the SVG card of a metric in d.Files and returns its embed
+func (d *DataContainer) renderSVG(key string, c *writer.Card) string {
+	path := filepath.Join(d.Config.SVGDir, writer.SVGFileName(key))
+	d.Files = append(d.Files, OutputFile{
+		Path:    path,
+		Content: []byte(writer.MakeSVGCard(c, d.Config.SVGTheme)),
+	})
+
+	return writer.MakeSVGEmbed(filepath.ToSlash(path), c.Title)
+}
+
+// Stats holds the rendered README output of a run
+type Stats struct {
+	Order       []string          // SHOW_METRICS keys that have output, in order
+	Metrics     map[string]string // rendered output per metric key
+	LastUpdated string            // empty unless SHOW_LAST_UPDATE is enabled
+	Template    string            // TEMPLATE_FILE output; replaces the combined section when set
+}
+
+// Section returns the combined output for the main marker section, leaving out
+// the metrics in placed because they are filled into their own marker section
+func (s *Stats) Section(placed map[string]bool) string {
+	if s.Template != "" {
+		return s.Template
+	}
+
 	b := strings.Builder{}
+	for _, k := range s.Order {
+		if placed[k] {
+			continue
+		}
+
+		b.WriteString(s.Metrics[k])
+	}
+	b.WriteString(s.LastUpdated)
+
+	return b.String()
+}
+
+// RelativeTo returns a copy of s whose embedded files are linked relative to dir,
+// the directory of the target file the output is written into
+func (s *Stats) RelativeTo(dir string, files []OutputFile) *Stats {
+	dir = filepath.Clean(dir)
+	if dir == "." {
+		return s
+	}
+
+	var pairs []string
+	for _, f := range files {
+		rel, err := filepath.Rel(dir, f.Path)
+		if err != nil {
+			continue
+		}
+
+		pairs = append(pairs,
+			`src="`+html.EscapeString(filepath.ToSlash(f.Path))+`"`,
+			`src="`+html.EscapeString(filepath.ToSlash(rel))+`"`,
+		)
+	}
+
+	if len(pairs) == 0 {
+		return s
+	}
+
+	r := strings.NewReplacer(pairs...)
+	c := &Stats{
+		Order:       s.Order,
+		Metrics:     make(map[string]string, len(s.Metrics)),
+		LastUpdated: s.LastUpdated,
+		Template:    r.Replace(s.Template),
+	}
+	for k, v := range s.Metrics {
+		c.Metrics[k] = r.Replace(v)
+	}
+
+	return c
+}
+
+// GetStats returns the statistics, rendered through TEMPLATE_FILE when one is configured
+func (d *DataContainer) GetStats(c clock.Clock) (*Stats, error) {
+	d.Files = nil
+	s := &Stats{Metrics: make(map[string]string, len(d.Config.ShowMetrics))}
 
 	// show metrics based on the environment variable
-	w := d.metrics(d.CalculateCommits(), d.CalculateLanguages(), d.CalculateAIStats())
+	now := c.Now()
+	com := d.CalculateCommits(now)
+	w := d.metrics(now, com, d.CalculateLanguages(), d.CalculateAIStats())
 	for _, k := range d.Config.ShowMetrics {
 		v, ok := w[k]
 		if !ok {
 			continue
 		}
 
-		b.WriteString(v)
+		if _, seen := s.Metrics[k]; !seen {
+			s.Order = append(s.Order, k)
+			s.Metrics[k] = d.render(k, v)
+		}
+	}
+
+	if d.Config.TemplateFile != "" {
+		out, err := d.executeTemplate(c, com, w, s.Metrics)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Template = out
+		return s, nil
 	}
 
 	// Show last update time if enabled
-	showLastUpdated(c, &b, d.Config)
+	s.LastUpdated = lastUpdated(c, d.Config)
 
 	if !d.Config.SimpleLogs {
 		d.Logger.Println("Created statistics successfully")
 	}
 
-	return b.String()
+	return s, nil
 }
 
-func showLastUpdated(cl clock.Clock, b *strings.Builder, cfg *config.Config) {
-	if cfg.ShowLastUpdate {
-		layout := cfg.TimeLayout
-		if layout == "" {
-			layout = clock.DateTimeFormatWithTimezone
-		}
+func lastUpdated(cl clock.Clock, cfg *config.Config) string {
+	if !cfg.ShowLastUpdate {
+		return ""
+	}
 
-		b.WriteString(writer.MakeLastUpdatedOn(cl.Now().Format(layout)))
+	layout := cfg.TimeLayout
+	if layout == "" {
+		layout = clock.DateTimeFormatWithTimezone
 	}
+
+	return writer.MakeLastUpdatedOn(cl.Now().Format(layout))
 }
 
 // InitViewer initializes the viewer
@@ -209,11 +438,11 @@ func (d *DataContainer) InitCommits(ctx context.Context) error {
 	hiddenRepoInfo := d.Config.HideRepoInfo
 	repoCount := len(d.Data.Repositories)
 	type commitResult struct {
+		index   int // position of the repository in d.Data.Repositories
 		commits []github.Commit
 		err     error
 	}
 	resultChan := make(chan commitResult, repoCount)
-	seenOIDs := make(map[string]bool)
 
 	mask := func(input string) string {
 		length := len(input)
@@ -249,7 +478,7 @@ func (d *DataContainer) InitCommits(ctx context.Context) error {
 					if !hiddenRepoInfo && !d.Config.SimpleLogs {
 						d.Logger.Printf("%s Reusing %d cached commits: %s\n", progress, len(cached), mask(repo.Name))
 					}
-					resultChan <- commitResult{commits: cached}
+					resultChan <- commitResult{index: i, commits: cached}
 					return
 				}
 			}
@@ -266,7 +495,7 @@ func (d *DataContainer) InitCommits(ctx context.Context) error {
 				// invalidating the cache.
 				d.Cache.Set(repo.Url, repo.PushedAt, fetched)
 			}
-			resultChan <- commitResult{commits: fetched}
+			resultChan <- commitResult{index: i, commits: fetched}
 		}(i, repo)
 	}
 
@@ -275,14 +504,23 @@ func (d *DataContainer) InitCommits(ctx context.Context) error {
 		close(resultChan)
 	}()
 
+	commits := make([][]github.Commit, repoCount)
 	for result := range resultChan {
 		if result.err != nil {
 			return result.err
 		}
-		for _, commit := range result.commits {
+		commits[result.index] = result.commits
+	}
+
+	// a commit found in several repositories, such as a fork and its upstream, is credited to the
+	// first one in attribution order, so it stays with the same repository from run to run
+	seenOIDs := make(map[string]bool)
+	for _, i := range d.commitAttributionOrder() {
+		for _, commit := range commits[i] {
 			if !seenOIDs[commit.OID] {
 				seenOIDs[commit.OID] = true
 				commit.CommittedDate = d.Clock.ToClockTz(commit.CommittedDate)
+				commit.Repository = d.Data.Repositories[i].Url
 				d.Data.Commits = append(d.Data.Commits, commit)
 			}
 		}
@@ -294,6 +532,38 @@ func (d *DataContainer) InitCommits(ctx context.Context) error {
 	return nil
 }
 
+// commitAttributionOrder returns the indexes of d.Data.Repositories in the order shared commits
+// are credited: repositories that are not forks first, then the viewer's own, then by URL
+func (d *DataContainer) commitAttributionOrder() []int {
+	var login string
+	if d.Data.Viewer != nil {
+		login = d.Data.Viewer.Login
+	}
+
+	repos := d.Data.Repositories
+	owned := func(r github.Repository) bool {
+		return login != "" && strings.EqualFold(r.Owner.Login, login)
+	}
+
+	order := make([]int, len(repos))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		ra, rb := repos[order[a]], repos[order[b]]
+		if ra.IsFork != rb.IsFork {
+			return !ra.IsFork
+		}
+		if owned(ra) != owned(rb) {
+			return owned(ra)
+		}
+
+		return ra.Url < rb.Url
+	})
+
+	return order
+}
+
 func (d *DataContainer) fetchRepoCommits(
 	ctx context.Context,
 	repo github.Repository,
@@ -373,9 +643,16 @@ func (d *DataContainer) InitWakaStats(ctx context.Context) error {
 		d.Logger.Println("Fetching WakaTime statistics...")
 	}
 
-	v, err := d.ClientManager.GetWakaTimeStats(ctx)
+	var v *wakatime.Stats
+	err := d.pollWakaTime(ctx, func() (err error) {
+		v, err = d.ClientManager.GetWakaTimeStats(ctx)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, wakatime.ErrStatsNotReady) {
+			if !d.Config.SimpleLogs {
+				d.Logger.Println("WakaTime is not ready yet")
+			}
 			d.restoreCachedWakaTimeStats()
 			return nil
 		}
@@ -383,22 +660,13 @@ func (d *DataContainer) InitWakaStats(ctx context.Context) error {
 		return err
 	}
 
-	// if the status is not "ok", print the message
+	// stats that are still processing come back as ErrStatsNotReady, so any other status is an error
 	if v.Data.Status != "ok" {
-		switch v.Data.Status {
-		case "pending_update":
-			if !d.Config.SimpleLogs {
-				d.Logger.Println("WakaTime is not ready yet")
-			}
-			d.restoreCachedWakaTimeStats()
-			return nil
-		default:
-			if !d.Config.SimpleLogs {
-				d.Logger.Println("An error occurred while fetching WakaTime data:", v.Data.Status)
-			}
-
-			return nil // Skip if the status is unknown
+		if !d.Config.SimpleLogs {
+			d.Logger.Println("An error occurred while fetching WakaTime data:", v.Data.Status)
 		}
+
+		return nil // Skip if the status is unknown
 	}
 
 	d.Data.WakaTime = v
@@ -407,7 +675,11 @@ func (d *DataContainer) InitWakaStats(ctx context.Context) error {
 	if !d.Config.SimpleLogs {
 		d.Logger.Println("Fetching WakaTime all-time statistics...")
 	}
-	allTimeStats, err := d.ClientManager.GetWakaTimeAllTimeSinceToday(ctx)
+	var allTimeStats *wakatime.AllTimeSinceTodayStats
+	err = d.pollWakaTime(ctx, func() (err error) {
+		allTimeStats, err = d.ClientManager.GetWakaTimeAllTimeSinceToday(ctx)
+		return err
+	})
 	if err == nil {
 		d.Data.WakaTimeAllTime = allTimeStats
 	} else {
@@ -430,6 +702,39 @@ func (d *DataContainer) InitWakaStats(ctx context.Context) error {
 	return nil
 }
 
+// pollWakaTime calls fetch until it returns something other than wakatime.ErrStatsNotReady,
+// backing off exponentially between attempts. All WakaTime requests of a run share one
+// WAKATIME_WAIT_SECONDS window, which starts with the first request; once it has passed, or
+// when waiting is disabled, the last ErrStatsNotReady is returned.
+func (d *DataContainer) pollWakaTime(ctx context.Context, fetch func() error) error {
+	if d.wakaDeadline.IsZero() {
+		d.wakaDeadline = d.Clock.Now().Add(time.Duration(d.Config.WakaTimeWaitSeconds) * time.Second)
+	}
+
+	for delay := wakaPollInitialDelay; ; delay = min(2*delay, wakaPollMaxDelay) {
+		err := fetch()
+		if !errors.Is(err, wakatime.ErrStatsNotReady) {
+			return err
+		}
+
+		remaining := d.wakaDeadline.Sub(d.Clock.Now())
+		if remaining <= 0 {
+			return err
+		}
+
+		wait := min(delay, remaining)
+		if !d.Config.SimpleLogs {
+			d.Logger.Printf("WakaTime is still processing; retrying in %s", wait)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-d.Clock.After(wait):
+		}
+	}
+}
+
 func (d *DataContainer) restoreCachedWakaTimeStats() bool {
 	if d.Cache == nil {
 		return false
@@ -460,6 +765,117 @@ func (d *DataContainer) cacheWakaTimeStats() {
 	d.Cache.SetWakaTime(d.Config.WakaTimeRange, d.Data.WakaTime, d.Data.WakaTimeAllTime)
 }
 
+// InitWakaSummaries initializes the WakaTime daily summaries of the last WAKATIME_DAILY_DAYS days.
+// The summaries are optional, so a failed request falls back to the cache or skips WAKATIME_DAILY;
+// only a cancelled run returns an error.
+func (d *DataContainer) InitWakaSummaries(ctx context.Context) error {
+	if !d.Config.SimpleLogs {
+		d.Logger.Println("Fetching WakaTime daily summaries...")
+	}
+
+	end := d.Clock.Now()
+	start := end.AddDate(0, 0, -(d.Config.WakaTimeDailyDays - 1))
+	var v *wakatime.Summaries
+	err := d.pollWakaTime(ctx, func() (err error) {
+		v, err = d.ClientManager.GetWakaTimeSummaries(ctx, start, end)
+		return err
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+
+		if !errors.Is(err, wakatime.ErrStatsNotReady) {
+			d.Logger.Printf("⚠️ Failed to fetch WakaTime daily summaries: %v", err)
+		}
+		d.restoreCachedWakaTimeSummaries()
+
+		return nil
+	}
+
+	d.Data.WakaTimeSummaries = v
+	if d.Cache != nil {
+		d.Cache.SetWakaTimeSummaries(d.Config.WakaTimeDailyDays, v)
+	}
+
+	return nil
+}
+
+func (d *DataContainer) restoreCachedWakaTimeSummaries() bool {
+	if d.Cache == nil {
+		return false
+	}
+
+	summaries, ok := d.Cache.LookupWakaTimeSummaries(d.Config.WakaTimeDailyDays)
+	if !ok {
+		if !d.Config.SimpleLogs {
+			d.Logger.Println("No cached WakaTime summaries available; skipping daily coding time for this run")
+		}
+		return false
+	}
+
+	d.Data.WakaTimeSummaries = summaries
+	if !d.Config.SimpleLogs {
+		d.Logger.Println("Reusing cached WakaTime summaries")
+	}
+
+	return true
+}
+
+// InitWakaGoals initializes the WakaTime coding goals. The goals are optional, so a failed
+// request falls back to the cache or skips WAKATIME_GOALS; only a cancelled run returns an error.
+func (d *DataContainer) InitWakaGoals(ctx context.Context) error {
+	if !d.Config.SimpleLogs {
+		d.Logger.Println("Fetching WakaTime goals...")
+	}
+
+	var v *wakatime.Goals
+	err := d.pollWakaTime(ctx, func() (err error) {
+		v, err = d.ClientManager.GetWakaTimeGoals(ctx)
+		return err
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+
+		if !errors.Is(err, wakatime.ErrStatsNotReady) {
+			d.Logger.Printf("⚠️ Failed to fetch WakaTime goals: %v", err)
+		}
+		d.restoreCachedWakaTimeGoals()
+
+		return nil
+	}
+
+	d.Data.WakaTimeGoals = v
+	if d.Cache != nil {
+		d.Cache.SetWakaTimeGoals(v)
+	}
+
+	return nil
+}
+
+func (d *DataContainer) restoreCachedWakaTimeGoals() bool {
+	if d.Cache == nil {
+		return false
+	}
+
+	goals, ok := d.Cache.LookupWakaTimeGoals()
+	if !ok {
+		if !d.Config.SimpleLogs {
+			d.Logger.Println("No cached WakaTime goals available; skipping goals for this run")
+		}
+		return false
+	}
+
+	d.Data.WakaTimeGoals = goals
+	if !d.Config.SimpleLogs {
+		d.Logger.Println("Reusing cached WakaTime goals")
+	}
+
+	return true
+}
+
 // Build builds the data container
 func (d *DataContainer) Build(ctx context.Context) error {
 	d.Logger.Println("Building data container...")
@@ -531,6 +947,21 @@ func (d *DataContainer) Build(ctx context.Context) error {
 			return err
 		}
 
+		// summaries cost an extra request per run, so only fetch them when they are shown
+		if d.Config.ShowsMetric(config.MetricWakaTimeDaily) {
+			err = d.InitWakaSummaries(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		if d.Config.ShowsMetric(config.MetricWakaTimeGoals) {
+			err = d.InitWakaGoals(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
 		if !d.Config.SimpleLogs {
 			d.Logger.Println("Fetching data from Wakatime APIs successfully")
 		}
@@ -0,0 +1,281 @@
+package container
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thanhhaudev/github-stats/pkg/config"
+	"github.com/thanhhaudev/github-stats/pkg/wakatime"
+	"github.com/thanhhaudev/github-stats/pkg/writer"
+)
+
+// ExportSchemaVersion bumps whenever a field of the JSON export is renamed,
+// removed or changes meaning. Adding new fields does not bump it, so consumers
+// should ignore keys they do not know.
+const ExportSchemaVersion = 1
+
+// exportPrivateProjects names the WakaTime projects grouped together by HIDE_REPO_INFO
+const exportPrivateProjects = "Private repos"
+
+// Export is the machine-readable form of every computed statistic.
+// Field names are part of the public schema; keep them stable.
+type Export struct {
+	SchemaVersion   int                `json:"schemaVersion"`
+	Commits         ExportCommits      `json:"commits"`
+	Streaks         ExportStreaks      `json:"streaks"`
+	Languages       []ExportLanguage   `json:"languages"`
+	AI              *ExportAI          `json:"ai,omitempty"`
+	WakaTime        *ExportWakaTime    `json:"wakaTime,omitempty"`
+	WakaTimeAllTime *ExportWakaAllTime `json:"wakaTimeAllTime,omitempty"`
+}
+
+type ExportCommits struct {
+	Total     int            `json:"total"`
+	Yearly    map[string]int `json:"yearly"`
+	Quarterly map[string]int `json:"quarterly"`
+	Weekdays  map[string]int `json:"weekdays"`
+}
+
+type ExportStreaks struct {
+	Current    int            `json:"current"`
+	Longest    int            `json:"longest"`
+	Top        []ExportStreak `json:"top"`                  // the TOP_STREAKS longest, longest first
+	LongestGap *ExportStreak  `json:"longestGap,omitempty"` // omitted without a gap between commits
+	LastCommit string         `json:"lastCommit,omitempty"` // date of the most recent commit
+}
+
+// ExportStreak is a run of days with its first and last day as YYYY-MM-DD dates
+type ExportStreak struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	Days  int    `json:"days"`
+}
+
+type ExportLanguage struct {
+	Name    string  `json:"name"`
+	Color   string  `json:"color"`
+	Size    int     `json:"size"`
+	Percent float64 `json:"percent"`
+}
+
+type ExportAI struct {
+	AIAdditions     int64   `json:"aiAdditions"`
+	AIDeletions     int64   `json:"aiDeletions"`
+	HumanAdditions  int64   `json:"humanAdditions"`
+	HumanDeletions  int64   `json:"humanDeletions"`
+	AIInputTokens   int64   `json:"aiInputTokens"`
+	AIOutputTokens  int64   `json:"aiOutputTokens"`
+	AvgPromptLength float64 `json:"avgPromptLength"`
+	PromptLength    int64   `json:"promptLength"`
+}
+
+// ExportWakaTime holds the WakaTime breakdowns. Categories, machines, dependencies and branches
+// are only exported when WAKATIME_DATA selects them, as machine and branch names can be private.
+type ExportWakaTime struct {
+	Range            string           `json:"range"`
+	Languages        []ExportWakaItem `json:"languages"`
+	Editors          []ExportWakaItem `json:"editors"`
+	Projects         []ExportWakaItem `json:"projects"`
+	OperatingSystems []ExportWakaItem `json:"operatingSystems"`
+	Categories       []ExportWakaItem `json:"categories,omitempty"`
+	Machines         []ExportWakaItem `json:"machines,omitempty"`
+	Dependencies     []ExportWakaItem `json:"dependencies,omitempty"`
+	Branches         []ExportWakaItem `json:"branches,omitempty"`
+}
+
+// ExportWakaItem is one entry of a WakaTime breakdown, such as a language or an editor
+type ExportWakaItem struct {
+	Name         string  `json:"name"`
+	TotalSeconds int     `json:"totalSeconds"`
+	Text         string  `json:"text"`
+	Percent      float64 `json:"percent"`
+}
+
+type ExportWakaAllTime struct {
+	TotalSeconds float64 `json:"totalSeconds"`
+	DailyAverage float64 `json:"dailyAverage"`
+	Text         string  `json:"text"`
+	StartDate    string  `json:"startDate"`
+	EndDate      string  `json:"endDate"`
+}
+
+// BuildExport collects the computed statistics into the versioned export schema
+func (d *DataContainer) BuildExport() *Export {
+	com := d.CalculateCommits(d.Clock.Now())
+	lang := d.CalculateLanguages()
+
+	e := &Export{
+		SchemaVersion: ExportSchemaVersion,
+		Commits: ExportCommits{
+			Total:     com.TotalCommits,
+			Yearly:    make(map[string]int, len(com.YearlyCommits)),
+			Quarterly: com.QuarterlyCommits,
+			Weekdays:  make(map[string]int, len(com.DailyCommits)),
+		},
+		Streaks: ExportStreaks{
+			Current: com.CurrentStreak,
+			Longest: com.LongestStreak,
+			Top:     []ExportStreak{},
+		},
+		Languages: []ExportLanguage{},
+	}
+
+	h := d.streakHistory(com)
+	for _, streak := range h.Top {
+		e.Streaks.Top = append(e.Streaks.Top, exportStreak(streak))
+	}
+	if h.LongestGap.Days > 0 {
+		gap := exportStreak(h.LongestGap)
+		e.Streaks.LongestGap = &gap
+	}
+	if !h.LastCommit.IsZero() {
+		e.Streaks.LastCommit = h.LastCommit.Format(time.DateOnly)
+	}
+
+	for year, n := range com.YearlyCommits {
+		e.Commits.Yearly[strconv.Itoa(year)] = n
+	}
+
+	for day, n := range com.DailyCommits {
+		e.Commits.Weekdays[day.String()] = n
+	}
+
+	for name, v := range lang.Languages {
+		size := v[1].(int)
+		l := ExportLanguage{Name: name, Color: v[0].(string), Size: size}
+		if lang.TotalSize > 0 {
+			l.Percent = float64(size) / float64(lang.TotalSize) * 100
+		}
+		e.Languages = append(e.Languages, l)
+	}
+
+	// largest first, name as tie-breaker so the output is deterministic
+	sort.Slice(e.Languages, func(i, j int) bool {
+		if e.Languages[i].Size != e.Languages[j].Size {
+			return e.Languages[i].Size > e.Languages[j].Size
+		}
+		return e.Languages[i].Name < e.Languages[j].Name
+	})
+
+	if ai := d.CalculateAIStats(); ai.HasData {
+		e.AI = &ExportAI{
+			AIAdditions:     ai.AIAdditions,
+			AIDeletions:     ai.AIDeletions,
+			HumanAdditions:  ai.HumanAdditions,
+			HumanDeletions:  ai.HumanDeletions,
+			AIInputTokens:   ai.AIInputTokens,
+			AIOutputTokens:  ai.AIOutputTokens,
+			AvgPromptLength: ai.AvgPromptLength,
+			PromptLength:    ai.PromptLength,
+		}
+	}
+
+	if s := d.Data.WakaTime; s != nil {
+		e.WakaTime = &ExportWakaTime{
+			Range:            s.Data.Range,
+			Languages:        exportWakaItems(s.Data.Languages),
+			Editors:          exportWakaItems(s.Data.Editors),
+			Projects:         d.exportProjects(exportWakaItems(s.Data.Projects)),
+			OperatingSystems: exportWakaItems(s.Data.OperatingSystems),
+		}
+
+		for _, v := range d.Config.WakaTimeData {
+			switch v {
+			case config.WakaDataCategories:
+				e.WakaTime.Categories = exportWakaItems(s.Data.Categories)
+			case config.WakaDataMachines:
+				e.WakaTime.Machines = exportWakaItems(s.Data.Machines)
+			case config.WakaDataDependencies:
+				e.WakaTime.Dependencies = exportWakaItems(s.Data.Dependencies)
+			case config.WakaDataBranches:
+				e.WakaTime.Branches = exportWakaItems(s.Data.Branches)
+			}
+		}
+	}
+
+	if s := d.Data.WakaTimeAllTime; s != nil {
+		e.WakaTimeAllTime = &ExportWakaAllTime{
+			TotalSeconds: s.Data.TotalSeconds,
+			DailyAverage: s.Data.DailyAverage,
+			Text:         s.Data.Text,
+			StartDate:    s.Data.Range.StartDate,
+			EndDate:      s.Data.Range.EndDate,
+		}
+	}
+
+	return e
+}
+
+// exportStreak converts a streak or gap into the export schema
+func exportStreak(s writer.Streak) ExportStreak {
+	return ExportStreak{Start: s.Start.Format(time.DateOnly), End: s.End.Format(time.DateOnly), Days: s.Days}
+}
+
+// exportWakaItems converts WakaTime breakdown entries into the export schema
+func exportWakaItems(items []wakatime.StatsItem) []ExportWakaItem {
+	out := make([]ExportWakaItem, 0, len(items))
+	for _, v := range items {
+		out = append(out, ExportWakaItem{
+			Name:         v.Name,
+			TotalSeconds: v.Hours*3600 + v.Minutes*60 + v.Seconds,
+			Text:         v.Text,
+			Percent:      v.Percent,
+		})
+	}
+
+	return out
+}
+
+// exportProjects returns the WakaTime projects for the export. With HIDE_REPO_INFO only
+// projects named after a public repository keep their name; the others may be private
+// repositories, so they are counted together under exportPrivateProjects.
+func (d *DataContainer) exportProjects(projects []ExportWakaItem) []ExportWakaItem {
+	if !d.Config.HideRepoInfo {
+		return projects
+	}
+
+	public := make(map[string]bool, len(d.Data.Repositories))
+	for _, r := range d.Data.Repositories {
+		if !r.IsPrivate {
+			public[strings.ToLower(r.Name)] = true
+		}
+	}
+
+	items := make([]ExportWakaItem, 0, len(projects))
+	private := ExportWakaItem{Name: exportPrivateProjects}
+	for _, p := range projects {
+		if public[strings.ToLower(p.Name)] {
+			items = append(items, p)
+			continue
+		}
+
+		private.Percent += p.Percent
+		private.TotalSeconds += p.TotalSeconds
+	}
+
+	if private.Percent == 0 && private.TotalSeconds == 0 {
+		return items
+	}
+
+	private.Text = fmt.Sprintf("%d hrs %d mins", private.TotalSeconds/3600, private.TotalSeconds%3600/60)
+
+	// keep WakaTime's order, most time first
+	i := sort.Search(len(items), func(i int) bool { return items[i].Percent < private.Percent })
+
+	return slices.Insert(items, i, private)
+}
+
+// ExportJSON returns the JSON export as an indented document
+func (d *DataContainer) ExportJSON() ([]byte, error) {
+	b, err := json.MarshalIndent(d.BuildExport(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '\n'), nil
+}
@@ -0,0 +1,174 @@
+package container
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/thanhhaudev/github-stats/pkg/config"
+	"github.com/thanhhaudev/github-stats/pkg/github"
+	"github.com/thanhhaudev/github-stats/pkg/wakatime"
+)
+
+func TestExportJSONUsesVersionedSchema(t *testing.T) {
+	d := NewDataContainer(log.New(io.Discard, "", 0), &fakeDataClientManager{}, &config.Config{SimpleLogs: true, TopStreaks: 3})
+	d.Data.Commits = []github.Commit{
+		{CommittedDate: time.Date(2025, 2, 3, 9, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 18, 9, 0, 0, 0, time.UTC)},
+	}
+	repo := github.Repository{Name: "repo-one"}
+	repo.Languages.Edges = append(repo.Languages.Edges, struct {
+		Node github.Language `json:"node"`
+		Size int             `json:"size"`
+	}{Node: github.Language{Name: "Go", Color: "#00ADD8"}, Size: 300})
+	d.Data.Repositories = []github.Repository{repo}
+	d.Data.WakaTime = &wakatime.Stats{}
+	d.Data.WakaTime.Data.Range = "last_7_days"
+	d.Data.WakaTime.Data.Languages = []wakatime.StatsItem{{Name: "Go", Hours: 3}}
+
+	b, err := d.ExportJSON()
+	if err != nil {
+		t.Fatalf("ExportJSON returned error: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("export is not valid JSON: %v\n%s", err, b)
+	}
+
+	if got["schemaVersion"] != float64(ExportSchemaVersion) {
+		t.Fatalf("schemaVersion = %v, want %d", got["schemaVersion"], ExportSchemaVersion)
+	}
+
+	commits := got["commits"].(map[string]any)
+	if commits["total"] != float64(2) {
+		t.Fatalf("commits.total = %v, want 2", commits["total"])
+	}
+	if commits["yearly"].(map[string]any)["2025"] != float64(1) {
+		t.Fatalf("unexpected yearly commits: %v", commits["yearly"])
+	}
+	if commits["weekdays"].(map[string]any)["Monday"] != float64(2) {
+		t.Fatalf("unexpected weekday commits: %v", commits["weekdays"])
+	}
+
+	streaks := got["streaks"].(map[string]any)
+	if top := streaks["top"].([]any); len(top) != 2 || top[0].(map[string]any)["end"] != "2026-05-18" {
+		t.Fatalf("unexpected top streaks: %v", streaks["top"])
+	}
+	if gap := streaks["longestGap"].(map[string]any); gap["start"] != "2025-02-04" || gap["end"] != "2026-05-17" {
+		t.Fatalf("unexpected longest gap: %v", streaks["longestGap"])
+	}
+	if streaks["lastCommit"] != "2026-05-18" {
+		t.Fatalf("unexpected last commit: %v", streaks["lastCommit"])
+	}
+
+	languages := got["languages"].([]any)
+	if len(languages) != 1 || languages[0].(map[string]any)["percent"] != float64(100) {
+		t.Fatalf("unexpected languages: %v", languages)
+	}
+
+	if got["wakaTime"].(map[string]any)["range"] != "last_7_days" {
+		t.Fatalf("unexpected wakaTime: %v", got["wakaTime"])
+	}
+	wakaLanguages := got["wakaTime"].(map[string]any)["languages"].([]any)
+	if item := wakaLanguages[0].(map[string]any); item["totalSeconds"] != float64(3*3600) || item["hours"] != nil {
+		t.Fatalf("expected camelCase export items, got %v", item)
+	}
+	if _, ok := got["ai"]; ok {
+		t.Fatalf("ai should be omitted without AI activity: %v", got["ai"])
+	}
+	if _, ok := got["wakaTimeAllTime"]; ok {
+		t.Fatalf("wakaTimeAllTime should be omitted when missing: %v", got["wakaTimeAllTime"])
+	}
+}
+
+func TestExportJSONIsStableAcrossRuns(t *testing.T) {
+	d := NewDataContainer(log.New(io.Discard, "", 0), &fakeDataClientManager{}, &config.Config{SimpleLogs: true})
+	d.Data.Commits = []github.Commit{{CommittedDate: time.Date(2026, 5, 18, 9, 0, 0, 0, time.UTC)}}
+
+	first, err := d.ExportJSON()
+	if err != nil {
+		t.Fatalf("ExportJSON returned error: %v", err)
+	}
+	second, _ := d.ExportJSON()
+
+	// the file is committed whenever it changes, so unchanged stats must export identical bytes
+	if string(first) != string(second) {
+		t.Fatalf("export changed between runs:\n%s\n%s", first, second)
+	}
+}
+
+func TestExportGroupsPrivateWakaTimeProjects(t *testing.T) {
+	d := NewDataContainer(log.New(io.Discard, "", 0), &fakeDataClientManager{}, &config.Config{SimpleLogs: true, HideRepoInfo: true})
+	d.Data.Repositories = []github.Repository{{Name: "github-stats"}, {Name: "billing", IsPrivate: true}}
+	d.Data.WakaTime = &wakatime.Stats{}
+	d.Data.WakaTime.Data.Projects = []wakatime.StatsItem{
+		{Name: "billing", Percent: 40, Hours: 4},
+		{Name: "GitHub-Stats", Percent: 35, Hours: 3, Minutes: 30},
+		{Name: "scratch", Percent: 25, Hours: 2, Minutes: 30},
+	}
+
+	got := d.BuildExport().WakaTime.Projects
+
+	want := []ExportWakaItem{
+		{Name: exportPrivateProjects, TotalSeconds: 6*3600 + 30*60, Text: "6 hrs 30 mins", Percent: 65},
+		{Name: "GitHub-Stats", TotalSeconds: 3*3600 + 30*60, Percent: 35},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("projects = %+v, want %+v", got, want)
+	}
+
+	d.Config.HideRepoInfo = false
+	if got := d.BuildExport().WakaTime.Projects; len(got) != 3 || got[0].Name != "billing" {
+		t.Fatalf("expected every project without HIDE_REPO_INFO, got %+v", got)
+	}
+}
+
+func TestExportHidesPrivateWakaTimeProjectsWithoutTime(t *testing.T) {
+	d := NewDataContainer(log.New(io.Discard, "", 0), &fakeDataClientManager{}, &config.Config{SimpleLogs: true, HideRepoInfo: true})
+	d.Data.Repositories = []github.Repository{{Name: "github-stats"}, {Name: "billing", IsPrivate: true}}
+	d.Data.WakaTime = &wakatime.Stats{}
+	d.Data.WakaTime.Data.Projects = []wakatime.StatsItem{
+		{Name: "github-stats", Percent: 100, Hours: 1},
+		{Name: "billing"},
+	}
+
+	got := d.BuildExport().WakaTime.Projects
+
+	if len(got) != 1 || got[0].Name != "github-stats" {
+		t.Fatalf("expected only the public project, got %+v", got)
+	}
+}
+
+func TestExportOnlyIncludesSelectedWakaTimeBreakdowns(t *testing.T) {
+	d := NewDataContainer(log.New(io.Discard, "", 0), &fakeDataClientManager{}, &config.Config{SimpleLogs: true, WakaTimeData: []string{"LANGUAGES", "BRANCHES"}})
+	d.Data.WakaTime = &wakatime.Stats{}
+	d.Data.WakaTime.Data.Languages = []wakatime.StatsItem{{Name: "Go"}}
+	d.Data.WakaTime.Data.Machines = []wakatime.StatsItem{{Name: "work-laptop"}}
+	d.Data.WakaTime.Data.Dependencies = []wakatime.StatsItem{{Name: "cobra"}}
+	d.Data.WakaTime.Data.Branches = []wakatime.StatsItem{{Name: "main"}}
+
+	b, err := d.ExportJSON()
+	if err != nil {
+		t.Fatalf("ExportJSON returned error: %v", err)
+	}
+
+	var got struct {
+		WakaTime map[string]json.RawMessage `json:"wakaTime"`
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("export is not valid JSON: %v\n%s", err, b)
+	}
+
+	for _, key := range []string{"machines", "dependencies", "categories"} {
+		if _, ok := got.WakaTime[key]; ok {
+			t.Errorf("%s should be omitted unless WAKATIME_DATA selects it: %s", key, b)
+		}
+	}
+	if string(got.WakaTime["branches"]) == "" || string(got.WakaTime["languages"]) == "" {
+		t.Errorf("expected branches and languages in the export: %s", b)
+	}
+}
@@ -15,13 +15,19 @@ import (
 )
 
 type fakeDataClientManager struct {
-	mu         sync.Mutex
-	branches   []github.Branch
-	commitErr  error
-	commitRefs []string
-	wakaStats  *wakatime.Stats
-	allTime    *wakatime.AllTimeSinceTodayStats
-	allTimeErr error
+	mu             sync.Mutex
+	branches       []github.Branch
+	commitErr      error
+	commitRefs     []string
+	wakaStats      *wakatime.Stats
+	wakaStatsErr   error
+	allTime        *wakatime.AllTimeSinceTodayStats
+	allTimeErr     error
+	summaries      *wakatime.Summaries
+	summariesErr   error
+	summariesRange [2]time.Time
+	goals          *wakatime.Goals
+	goalsErr       error
 }
 
 func (f *fakeDataClientManager) HasGitHubClient() bool {
@@ -63,13 +69,22 @@ func (f *fakeDataClientManager) GetContributedToRepositories(ctx context.Context
 }
 
 func (f *fakeDataClientManager) GetWakaTimeStats(ctx context.Context) (*wakatime.Stats, error) {
-	return f.wakaStats, nil
+	return f.wakaStats, f.wakaStatsErr
 }
 
 func (f *fakeDataClientManager) GetWakaTimeAllTimeSinceToday(ctx context.Context) (*wakatime.AllTimeSinceTodayStats, error) {
 	return f.allTime, f.allTimeErr
 }
 
+func (f *fakeDataClientManager) GetWakaTimeGoals(ctx context.Context) (*wakatime.Goals, error) {
+	return f.goals, f.goalsErr
+}
+
+func (f *fakeDataClientManager) GetWakaTimeSummaries(ctx context.Context, start, end time.Time) (*wakatime.Summaries, error) {
+	f.summariesRange = [2]time.Time{start, end}
+	return f.summaries, f.summariesErr
+}
+
 func TestDataContainerInitCommitsReturnsBranchError(t *testing.T) {
 	branchErr := errors.New("branch fetch failed")
 	cm := &fakeDataClientManager{
@@ -118,4 +133,35 @@ func TestDataContainerInitCommitsDoesNotRequireContextClock(t *testing.T) {
 	if d.Data.Commits[0].OID != "refs/heads/main" {
 		t.Fatalf("expected default branch commit, got %q", d.Data.Commits[0].OID)
 	}
+	if d.Data.Commits[0].Repository != repo.Url {
+		t.Fatalf("expected commit attributed to %s, got %q", repo.Url, d.Data.Commits[0].Repository)
+	}
+}
+
+func TestDataContainerInitCommitsCreditsSharedCommitsDeterministically(t *testing.T) {
+	fork := github.Repository{Name: "upstream", Url: "https://github.com/a-viewer/upstream", IsFork: true}
+	fork.Owner.Login = "viewer"
+	upstream := github.Repository{Name: "upstream", Url: "https://github.com/zeta/upstream"}
+	upstream.Owner.Login = "zeta"
+	owned := github.Repository{Name: "mirror", Url: "https://github.com/viewer/mirror"}
+	owned.Owner.Login = "viewer"
+
+	for run := 0; run < 20; run++ {
+		cm := &fakeDataClientManager{}
+		cfg := &config.Config{OnlyMainBranch: true, SimpleLogs: true}
+		d := NewDataContainer(log.Default(), cm, cfg)
+		d.Data.Viewer = &github.Viewer{ID: "viewer-id", Login: "Viewer"}
+		d.Data.Repositories = []github.Repository{fork, upstream, owned}
+
+		if err := d.InitCommits(context.Background()); err != nil {
+			t.Fatalf("InitCommits returned error: %v", err)
+		}
+
+		if len(d.Data.Commits) != 1 {
+			t.Fatalf("expected the shared commit once, got %d", len(d.Data.Commits))
+		}
+		if d.Data.Commits[0].Repository != owned.Url {
+			t.Fatalf("run %d: expected commit credited to %s, got %q", run, owned.Url, d.Data.Commits[0].Repository)
+		}
+	}
 }
@@ -2,6 +2,7 @@ package container
 
 import (
 	"context"
+	"time"
 
 	"github.com/thanhhaudev/github-stats/pkg/github"
 	"github.com/thanhhaudev/github-stats/pkg/wakatime"
@@ -224,6 +225,26 @@ func (c *ClientManager) GetWakaTimeAllTimeSinceToday(ctx context.Context) (*waka
 	return stats, nil
 }
 
+// GetWakaTimeSummaries returns the user's coding activity for each day between start and end
+func (c *ClientManager) GetWakaTimeSummaries(ctx context.Context, start, end time.Time) (*wakatime.Summaries, error) {
+	summaries, err := c.WakaTimeClient.Summaries.Get(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// GetWakaTimeGoals returns the user's coding goals
+func (c *ClientManager) GetWakaTimeGoals(ctx context.Context) (*wakatime.Goals, error) {
+	goals, err := c.WakaTimeClient.Goals.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return goals, nil
+}
+
 // NewClientManager creates a new ClientManager
 func NewClientManager(w *wakatime.WakaTime, g *github.GitHub) *ClientManager {
 	cm := &ClientManager{WakaTimeClient: w, GitHubClient: g}
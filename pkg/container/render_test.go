@@ -0,0 +1,270 @@
+package container
+
+import (
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thanhhaudev/github-stats/pkg/clock"
+	"github.com/thanhhaudev/github-stats/pkg/config"
+	"github.com/thanhhaudev/github-stats/pkg/github"
+)
+
+func newRenderContainer(cfg *config.Config) *DataContainer {
+	d := NewDataContainer(log.New(io.Discard, "", 0), &fakeDataClientManager{}, cfg)
+	d.Data.Commits = []github.Commit{
+		{CommittedDate: time.Date(2026, 5, 18, 9, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 19, 21, 0, 0, 0, time.UTC)},
+	}
+
+	return d
+}
+
+func TestGetStatsRendersSVGCards(t *testing.T) {
+	d := newRenderContainer(&config.Config{
+		ShowMetrics: []string{config.MetricCommitDaysOfWeek},
+		RenderStyle: config.RenderStyleSVG,
+		SVGTheme:    "auto",
+		SVGDir:      "assets",
+		SimpleLogs:  true,
+	})
+
+	s, err := d.GetStats(clock.NewClock())
+	if err != nil {
+		t.Fatalf("GetStats returned error: %v", err)
+	}
+	got := s.Section(nil)
+
+	if !strings.Contains(got, `<img src="assets/github-stats-commit-days-of-week.svg"`) {
+		t.Fatalf("expected SVG embed, got %q", got)
+	}
+	if len(d.Files) != 1 || d.Files[0].Path != "assets/github-stats-commit-days-of-week.svg" {
+		t.Fatalf("unexpected files: %+v", d.Files)
+	}
+	if !strings.HasPrefix(string(d.Files[0].Content), "<svg") {
+		t.Fatalf("expected SVG content, got %q", d.Files[0].Content)
+	}
+}
+
+func TestGetStatsSkipsSVGForEmptyMetric(t *testing.T) {
+	d := newRenderContainer(&config.Config{
+		ShowMetrics: []string{config.MetricLanguagePerRepo},
+		RenderStyle: config.RenderStyleSVG,
+		SimpleLogs:  true,
+	})
+
+	s, err := d.GetStats(clock.NewClock())
+	if err != nil {
+		t.Fatalf("GetStats returned error: %v", err)
+	}
+	got := s.Section(nil)
+	if got != "" {
+		t.Fatalf("expected empty output, got %q", got)
+	}
+	if len(d.Files) != 0 {
+		t.Fatalf("expected no files, got %+v", d.Files)
+	}
+}
+
+func TestGetStatsMixesMetricStyles(t *testing.T) {
+	d := newRenderContainer(&config.Config{
+		ShowMetrics:  []string{config.MetricCommitDaysOfWeek, config.MetricCommitTimesOfDay},
+		RenderStyle:  config.RenderStyleText,
+		MetricStyles: map[string]string{config.MetricCommitDaysOfWeek: config.RenderStylePie},
+		SimpleLogs:   true,
+	})
+
+	s, err := d.GetStats(clock.NewClock())
+	if err != nil {
+		t.Fatalf("GetStats returned error: %v", err)
+	}
+
+	if got := s.Metrics[config.MetricCommitDaysOfWeek]; !strings.Contains(got, "```mermaid\npie showData\n") {
+		t.Errorf("expected a pie chart for %s, got:\n%s", config.MetricCommitDaysOfWeek, got)
+	}
+	if got := s.Metrics[config.MetricCommitTimesOfDay]; !strings.Contains(got, "```text") {
+		t.Errorf("expected text bars for %s, got:\n%s", config.MetricCommitTimesOfDay, got)
+	}
+}
+
+func TestGetStatsRendersMarkdownTables(t *testing.T) {
+	d := newRenderContainer(&config.Config{
+		ShowMetrics:  []string{config.MetricCommitDaysOfWeek, config.MetricCommitTimesOfDay},
+		RenderStyle:  config.RenderStyleTable,
+		MetricStyles: map[string]string{config.MetricCommitTimesOfDay: config.RenderStyleText},
+		SimpleLogs:   true,
+	})
+
+	s, err := d.GetStats(clock.NewClock())
+	if err != nil {
+		t.Fatalf("GetStats returned error: %v", err)
+	}
+
+	if got := s.Metrics[config.MetricCommitDaysOfWeek]; !strings.Contains(got, "| Monday | 1 commit |") || strings.Contains(got, "```") {
+		t.Errorf("expected a Markdown table for %s, got:\n%s", config.MetricCommitDaysOfWeek, got)
+	}
+	if got := s.Metrics[config.MetricCommitTimesOfDay]; !strings.Contains(got, "```text") {
+		t.Errorf("expected text bars for %s, got:\n%s", config.MetricCommitTimesOfDay, got)
+	}
+}
+
+func TestGetStatsAppliesMetricLayouts(t *testing.T) {
+	d := newRenderContainer(&config.Config{
+		ShowMetrics:    []string{config.MetricCommitDaysOfWeek, config.MetricCommitTimesOfDay, config.MetricCodeChurn},
+		HeadingLevel:   3,
+		MetricHeadings: map[string]string{config.MetricCodeChurn: "0"},
+		MetricDetails:  map[string]string{config.MetricCommitTimesOfDay: "", config.MetricCodeChurn: "Churn · {title}"},
+		SimpleLogs:     true,
+	})
+
+	s, err := d.GetStats(clock.NewClock())
+	if err != nil {
+		t.Fatalf("GetStats returned error: %v", err)
+	}
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: config.MetricCommitDaysOfWeek, want: "### 📅 I'm Most Productive on Monday\n\n```text"},
+		{key: config.MetricCommitTimesOfDay, want: "<details>\n<summary>🕒 I&#39;m An Early Bird 🐤</summary>\n\n```text"},
+		{key: config.MetricCodeChurn, want: "<summary>Churn · 📝 Code Churn</summary>\n\n**📝 Code Churn**\n\n```text"},
+		{key: config.MetricCodeChurn, want: "```\n\n</details>\n\n"},
+	}
+
+	for _, tt := range tests {
+		if got := s.Metrics[tt.key]; !strings.Contains(got, tt.want) {
+			t.Errorf("expected %s to contain %q, got:\n%s", tt.key, tt.want, got)
+		}
+	}
+}
+
+func TestGetStatsExecutesTemplateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.tmpl")
+	tmpl := `Total: {{.Commits.Total}} ({{index .Commits.Weekdays "Monday"}} on Mondays)
+{{with .CommitDaysOfWeek}}{{.Title}}
+{{range (index .Groups 0).Rows}}{{if gt .Percent 0.0}}{{.Name}} {{progressBar .Percent}} {{formatPercent .Percent}}
+{{end}}{{end}}{{end}}{{index .Metrics "COMMIT_TIMES_OF_DAY"}}`
+	if err := os.WriteFile(path, []byte(tmpl), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d := newRenderContainer(&config.Config{
+		ShowMetrics:  []string{config.MetricCommitTimesOfDay},
+		TemplateFile: path,
+		SimpleLogs:   true,
+	})
+
+	s, err := d.GetStats(clock.NewClock())
+	if err != nil {
+		t.Fatalf("GetStats returned error: %v", err)
+	}
+	got := s.Section(nil)
+
+	for _, want := range []string{
+		"Total: 2 (1 on Mondays)",
+		"📅 I'm Most Productive on Monday",
+		"Monday █████████████░░░░░░░░░░░░ 50.00%",
+		"Tuesday",
+		"**🕒 I'm An Early Bird 🐤**",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected template output to contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Sunday") {
+		t.Errorf("template filter should skip empty weekdays, got:\n%s", got)
+	}
+}
+
+func TestGetStatsTemplateUsesMetricProgressBars(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.tmpl")
+	tmpl := `{{with .CommitsPerYear}}{{.Title}}{{end}}
+streaks: {{len .Streak.Top}}
+default: {{progressBar 50.0}}
+per metric: {{progressBar 50.0 "COMMITS_PER_YEAR"}}`
+	if err := os.WriteFile(path, []byte(tmpl), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d := newRenderContainer(&config.Config{
+		ShowMetrics:        []string{config.MetricCommitsPerYear},
+		MetricProgressBars: map[string]string{config.MetricCommitsPerYear: config.ProgressBarVersion2},
+		ProgressBarVersion: config.ProgressBarVersion1,
+		TemplateFile:       path,
+		TopStreaks:         3,
+		SimpleLogs:         true,
+	})
+
+	s, err := d.GetStats(clock.NewClock())
+	if err != nil {
+		t.Fatalf("GetStats returned error: %v", err)
+	}
+	got := s.Section(nil)
+
+	for _, want := range []string{
+		"📈 Commits per Year",
+		"streaks: 1",
+		"default: █████████████░░░░░░░░░░░░",
+		"per metric: 🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟩🟨⬜",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected template output to contain %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestGetStatsReturnsTemplateErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "broken.tmpl")
+	if err := os.WriteFile(path, []byte("{{.Missing"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d := newRenderContainer(&config.Config{
+		ShowMetrics:  []string{config.MetricCommitTimesOfDay},
+		TemplateFile: path,
+		SimpleLogs:   true,
+	})
+
+	if _, err := d.GetStats(clock.NewClock()); err == nil {
+		t.Fatal("expected template parse error, got nil")
+	}
+}
+
+func TestStatsRelativeToTargetDirectory(t *testing.T) {
+	files := []OutputFile{{Path: "assets/github-stats-coding-streak.svg"}}
+	s := &Stats{
+		Order:    []string{config.MetricCodingStreak},
+		Metrics:  map[string]string{config.MetricCodingStreak: `<img src="assets/github-stats-coding-streak.svg" alt="streak" />`},
+		Template: `<img src="assets/github-stats-coding-streak.svg" alt="streak" />`,
+	}
+
+	tests := []struct {
+		dir  string
+		want string
+	}{
+		{dir: ".", want: `src="assets/github-stats-coding-streak.svg"`},
+		{dir: "docs", want: `src="../assets/github-stats-coding-streak.svg"`},
+		{dir: "assets", want: `src="github-stats-coding-streak.svg"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			got := s.RelativeTo(tt.dir, files)
+			if !strings.Contains(got.Metrics[config.MetricCodingStreak], tt.want) {
+				t.Errorf("metric output %q does not contain %q", got.Metrics[config.MetricCodingStreak], tt.want)
+			}
+			if !strings.Contains(got.Template, tt.want) {
+				t.Errorf("template output %q does not contain %q", got.Template, tt.want)
+			}
+		})
+	}
+
+	if !strings.Contains(s.Metrics[config.MetricCodingStreak], `src="assets/`) {
+		t.Error("RelativeTo must not modify the original stats")
+	}
+}
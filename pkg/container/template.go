@@ -0,0 +1,68 @@
+package container
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/thanhhaudev/github-stats/pkg/clock"
+	"github.com/thanhhaudev/github-stats/pkg/config"
+	"github.com/thanhhaudev/github-stats/pkg/writer"
+)
+
+// executeTemplate renders the README section through the user's TEMPLATE_FILE
+// rendered holds the built-in output of the SHOW_METRICS entries, exposed as .Metrics.
+func (d *DataContainer) executeTemplate(c clock.Clock, com *CommitStats, w map[string]metric, rendered map[string]string) (string, error) {
+	text, err := os.ReadFile(d.Config.TemplateFile)
+	if err != nil {
+		return "", fmt.Errorf("read template: %w", err)
+	}
+
+	data := &writer.TemplateData{
+		Commits: writer.TemplateCommits{
+			Total:     com.TotalCommits,
+			Yearly:    com.YearlyCommits,
+			Quarterly: com.QuarterlyCommits,
+			Weekdays:  make(map[string]int, len(com.DailyCommits)),
+		},
+		Streak:            d.streakHistory(com),
+		Languages:         w[config.MetricLanguagesAndTools].card,
+		LanguagePerRepo:   w[config.MetricLanguagePerRepo].card,
+		CommitDaysOfWeek:  w[config.MetricCommitDaysOfWeek].card,
+		CommitTimesOfDay:  w[config.MetricCommitTimesOfDay].card,
+		CommitHours:       w[config.MetricCommitHours].card,
+		CommitsPerYear:    w[config.MetricCommitsPerYear].card,
+		CommitsPerQuarter: w[config.MetricCommitsPerQuarter].card,
+		CommitCalendar:    w[config.MetricCommitCalendar].card,
+		CommitPunchCard:   w[config.MetricCommitPunchCard].card,
+		CommitSizes:       w[config.MetricCommitSizes].card,
+		CommitTrend:       w[config.MetricCommitTrend].card,
+		CodeChurn:         w[config.MetricCodeChurn].card,
+		TopRepositories:   w[config.MetricTopRepositories].card,
+		ProfileSummary:    w[config.MetricProfileSummary].card,
+		CodingStreak:      w[config.MetricCodingStreak].card,
+		WakaTime:          w[config.MetricWakaTimeSpentTime].card,
+		WakaTimeDaily:     w[config.MetricWakaTimeDaily].card,
+		WakaTimeGoals:     w[config.MetricWakaTimeGoals].card,
+		WakaTimeAllTime:   d.Data.WakaTimeAllTime,
+		AI:                w[config.MetricWakaTimeAIStats].card,
+		LastUpdated:       c.Now(),
+		Metrics:           rendered,
+	}
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		data.Commits.Weekdays[day.String()] = com.DailyCommits[day]
+	}
+
+	out, err := writer.MakeTemplate(filepath.Base(d.Config.TemplateFile), string(text), data, d.Config.BarStyleFor)
+	if err != nil {
+		return "", fmt.Errorf("execute template: %w", err)
+	}
+
+	if !d.Config.SimpleLogs {
+		d.Logger.Println("Created statistics from template successfully")
+	}
+
+	return out, nil
+}
@@ -67,11 +67,10 @@ func TestInitWakaStatsRestoresCacheWhenStatsPending(t *testing.T) {
 	c := &cache.Cache{Repos: make(map[string]*cache.RepoEntry)}
 	c.SetWakaTime("last_7_days", cachedStats, cachedAllTime)
 
-	pendingStats := &wakatime.Stats{}
-	pendingStats.Data.Status = "pending_update"
+	// the stats client reports pending_update and is_up_to_date=false as ErrStatsNotReady
 	d := NewDataContainer(
 		log.New(io.Discard, "", 0),
-		&fakeDataClientManager{wakaStats: pendingStats},
+		&fakeDataClientManager{wakaStatsErr: wakatime.ErrStatsNotReady},
 		&config.Config{WakaTimeRange: "last_7_days", SimpleLogs: true},
 	)
 	d.Cache = c
@@ -169,3 +168,113 @@ func TestInitWakaStatsKeepsFreshStatsWhenAllTimeErrors(t *testing.T) {
 		t.Fatalf("expected cached all-time reused, got %+v", d.Data.WakaTimeAllTime)
 	}
 }
+
+func TestInitWakaSummariesFetchesWindowAndCaches(t *testing.T) {
+	summaries := &wakatime.Summaries{Data: []wakatime.Summary{{GrandTotal: wakatime.GrandTotal{TotalSeconds: 3600}}}}
+	cm := &fakeDataClientManager{summaries: summaries}
+	d := NewDataContainer(log.New(io.Discard, "", 0), cm, &config.Config{WakaTimeDailyDays: 7, SimpleLogs: true})
+	d.Cache = &cache.Cache{Repos: make(map[string]*cache.RepoEntry)}
+
+	if err := d.InitWakaSummaries(context.Background()); err != nil {
+		t.Fatalf("InitWakaSummaries returned error: %v", err)
+	}
+
+	if d.Data.WakaTimeSummaries != summaries {
+		t.Fatalf("summaries not stored: %+v", d.Data.WakaTimeSummaries)
+	}
+	if start, end := cm.summariesRange[0], cm.summariesRange[1]; !start.AddDate(0, 0, 6).Equal(end) {
+		t.Errorf("expected a 7 day window, got %v – %v", start, end)
+	}
+	if _, ok := d.Cache.LookupWakaTimeSummaries(7); !ok {
+		t.Error("expected fetched summaries to be cached")
+	}
+}
+
+func TestInitWakaSummariesRestoresCacheWhenNotReady(t *testing.T) {
+	cached := &wakatime.Summaries{Data: []wakatime.Summary{{GrandTotal: wakatime.GrandTotal{Text: "1 hr"}}}}
+	c := &cache.Cache{Repos: make(map[string]*cache.RepoEntry)}
+	c.SetWakaTimeSummaries(7, cached)
+
+	d := NewDataContainer(
+		log.New(io.Discard, "", 0),
+		&fakeDataClientManager{summariesErr: wakatime.ErrStatsNotReady},
+		&config.Config{WakaTimeDailyDays: 7, SimpleLogs: true},
+	)
+	d.Cache = c
+
+	if err := d.InitWakaSummaries(context.Background()); err != nil {
+		t.Fatalf("InitWakaSummaries returned error: %v", err)
+	}
+
+	if d.Data.WakaTimeSummaries == nil || d.Data.WakaTimeSummaries.Data[0].GrandTotal.Text != "1 hr" {
+		t.Fatalf("cached summaries not restored: %+v", d.Data.WakaTimeSummaries)
+	}
+}
+
+func TestInitWakaSummariesSkipsFailedRequests(t *testing.T) {
+	d := NewDataContainer(
+		log.New(io.Discard, "", 0),
+		&fakeDataClientManager{summariesErr: errors.New("503 Service Unavailable")},
+		&config.Config{WakaTimeDailyDays: 7, SimpleLogs: true},
+	)
+
+	if err := d.InitWakaSummaries(context.Background()); err != nil {
+		t.Fatalf("expected a failed summaries request to be skipped, got %v", err)
+	}
+	if d.Data.WakaTimeSummaries != nil {
+		t.Fatalf("expected no summaries, got %+v", d.Data.WakaTimeSummaries)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	d.ClientManager = &fakeDataClientManager{summariesErr: context.Canceled}
+	if err := d.InitWakaSummaries(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a cancelled run to return its error, got %v", err)
+	}
+}
+
+func TestInitWakaGoalsSkipsWhenNotReady(t *testing.T) {
+	d := NewDataContainer(
+		log.New(io.Discard, "", 0),
+		&fakeDataClientManager{goalsErr: wakatime.ErrStatsNotReady},
+		&config.Config{SimpleLogs: true},
+	)
+
+	if err := d.InitWakaGoals(context.Background()); err != nil {
+		t.Fatalf("InitWakaGoals returned error: %v", err)
+	}
+	if d.Data.WakaTimeGoals != nil {
+		t.Fatalf("expected no goals, got %+v", d.Data.WakaTimeGoals)
+	}
+
+	d.ClientManager = &fakeDataClientManager{goalsErr: errors.New("403 Forbidden")}
+	if err := d.InitWakaGoals(context.Background()); err != nil {
+		t.Fatalf("expected a failed goals request to be skipped, got %v", err)
+	}
+}
+
+func TestInitWakaGoalsCachesAndRestores(t *testing.T) {
+	goals := &wakatime.Goals{Data: []wakatime.Goal{{Title: "Code 1 hr per day"}}}
+	d := NewDataContainer(
+		log.New(io.Discard, "", 0),
+		&fakeDataClientManager{goals: goals},
+		&config.Config{SimpleLogs: true},
+	)
+	d.Cache = &cache.Cache{Repos: make(map[string]*cache.RepoEntry)}
+
+	if err := d.InitWakaGoals(context.Background()); err != nil {
+		t.Fatalf("InitWakaGoals returned error: %v", err)
+	}
+	if cached, ok := d.Cache.LookupWakaTimeGoals(); !ok || cached != goals {
+		t.Fatal("expected fetched goals to be cached")
+	}
+
+	d.Data.WakaTimeGoals = nil
+	d.ClientManager = &fakeDataClientManager{goalsErr: errors.New("502 Bad Gateway")}
+	if err := d.InitWakaGoals(context.Background()); err != nil {
+		t.Fatalf("InitWakaGoals returned error: %v", err)
+	}
+	if d.Data.WakaTimeGoals != goals {
+		t.Fatalf("cached goals not restored: %+v", d.Data.WakaTimeGoals)
+	}
+}
@@ -0,0 +1,189 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/thanhhaudev/github-stats/pkg/config"
+	"github.com/thanhhaudev/github-stats/pkg/wakatime"
+)
+
+// fakeClock advances its time by each duration passed to After instead of sleeping
+type fakeClock struct {
+	now   time.Time
+	waits []time.Duration
+}
+
+func (c *fakeClock) SetLocation(string) error                  { return nil }
+func (c *fakeClock) ToClockTz(t time.Time) time.Time           { return t }
+func (c *fakeClock) Now() time.Time                            { return c.now }
+func (c *fakeClock) FromUnix(u int64) time.Time                { return time.Unix(u, 0) }
+func (c *fakeClock) FromString(l, s string) (time.Time, error) { return time.Parse(l, s) }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+func newPollingContainer(waitSeconds int) (*DataContainer, *fakeClock) {
+	cl := &fakeClock{now: time.Date(2026, 5, 20, 0, 1, 0, 0, time.UTC)}
+	d := NewDataContainer(log.New(io.Discard, "", 0), &fakeDataClientManager{}, &config.Config{WakaTimeWaitSeconds: waitSeconds, SimpleLogs: true})
+	d.SetClock(cl)
+
+	return d, cl
+}
+
+func TestPollWakaTimeRetriesWithBackoffUntilReady(t *testing.T) {
+	d, cl := newPollingContainer(120)
+
+	attempts := 0
+	err := d.pollWakaTime(context.Background(), func() error {
+		attempts++
+		if attempts < 4 {
+			return wakatime.ErrStatsNotReady
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second}; !slices.Equal(cl.waits, want) {
+		t.Errorf("waits = %v, want %v", cl.waits, want)
+	}
+}
+
+func TestPollWakaTimeStopsAtDeadline(t *testing.T) {
+	d, cl := newPollingContainer(30)
+
+	attempts := 0
+	err := d.pollWakaTime(context.Background(), func() error {
+		attempts++
+		return wakatime.ErrStatsNotReady
+	})
+
+	if !errors.Is(err, wakatime.ErrStatsNotReady) {
+		t.Fatalf("expected ErrStatsNotReady, got %v", err)
+	}
+	// 5s + 10s + the remaining 15s of the window, then one last attempt
+	if want := []time.Duration{5 * time.Second, 10 * time.Second, 15 * time.Second}; !slices.Equal(cl.waits, want) || attempts != 4 {
+		t.Errorf("waits = %v after %d attempts, want %v after 4", cl.waits, attempts, want)
+	}
+
+	// the window is shared, so later WakaTime requests no longer wait
+	_ = d.pollWakaTime(context.Background(), func() error { return wakatime.ErrStatsNotReady })
+	if len(cl.waits) != 3 {
+		t.Errorf("expected no more waits once the window has passed, got %v", cl.waits)
+	}
+}
+
+func TestPollWakaTimeCapsDelay(t *testing.T) {
+	d, cl := newPollingContainer(600)
+
+	attempts := 0
+	_ = d.pollWakaTime(context.Background(), func() error {
+		attempts++
+		if attempts < 7 {
+			return wakatime.ErrStatsNotReady
+		}
+
+		return nil
+	})
+
+	if want := []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second, time.Minute, time.Minute}; !slices.Equal(cl.waits, want) {
+		t.Errorf("waits = %v, want %v", cl.waits, want)
+	}
+}
+
+func TestPollWakaTimeDisabledByDefault(t *testing.T) {
+	d, cl := newPollingContainer(0)
+
+	attempts := 0
+	err := d.pollWakaTime(context.Background(), func() error {
+		attempts++
+		return wakatime.ErrStatsNotReady
+	})
+
+	if !errors.Is(err, wakatime.ErrStatsNotReady) || attempts != 1 || len(cl.waits) != 0 {
+		t.Errorf("expected a single attempt without waiting, got %d attempts, waits %v, err %v", attempts, cl.waits, err)
+	}
+}
+
+func TestPollWakaTimeReturnsOtherErrorsImmediately(t *testing.T) {
+	d, cl := newPollingContainer(120)
+	failing := errors.New("boom")
+
+	err := d.pollWakaTime(context.Background(), func() error { return failing })
+
+	if !errors.Is(err, failing) || len(cl.waits) != 0 {
+		t.Errorf("expected the error without waiting, got %v, waits %v", err, cl.waits)
+	}
+}
+
+func TestPollWakaTimeRespectsContextCancellation(t *testing.T) {
+	d, _ := newPollingContainer(120)
+	d.SetClock(&blockingClock{fakeClock{now: time.Date(2026, 5, 20, 0, 1, 0, 0, time.UTC)}})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := d.pollWakaTime(ctx, func() error {
+		cancel()
+		return wakatime.ErrStatsNotReady
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestInitWakaStatsPollsUntilStatsAreReady(t *testing.T) {
+	ready := &wakatime.Stats{}
+	ready.Data.Status = "ok"
+
+	d, cl := newPollingContainer(60)
+	cm := &sequenceStatsClientManager{notReady: 2, stats: ready}
+	d.ClientManager = cm
+
+	if err := d.InitWakaStats(context.Background()); err != nil {
+		t.Fatalf("InitWakaStats returned error: %v", err)
+	}
+
+	if d.Data.WakaTime != ready || len(cl.waits) != 2 {
+		t.Errorf("expected fresh stats after two retries, got %+v with waits %v", d.Data.WakaTime, cl.waits)
+	}
+}
+
+// blockingClock never fires, so only context cancellation can end a wait
+type blockingClock struct {
+	fakeClock
+}
+
+func (c *blockingClock) After(time.Duration) <-chan time.Time {
+	return make(chan time.Time)
+}
+
+// sequenceStatsClientManager reports the stats as not ready for the first notReady requests
+type sequenceStatsClientManager struct {
+	fakeDataClientManager
+	notReady int
+	stats    *wakatime.Stats
+}
+
+func (f *sequenceStatsClientManager) GetWakaTimeStats(ctx context.Context) (*wakatime.Stats, error) {
+	if f.notReady > 0 {
+		f.notReady--
+		return nil, wakatime.ErrStatsNotReady
+	}
+
+	return f.stats, nil
+}
@@ -73,6 +73,7 @@ type Commit struct {
 	Deletions     int       `json:"deletions"`
 	CommittedDate time.Time `json:"committedDate"`
 	OID           string    `json:"oid"`
+	Repository    string    `json:"-"` // URL of the repository the commit was fetched from
 }
 
 type Commits struct {
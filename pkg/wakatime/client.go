@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -86,11 +87,16 @@ func (c *Client) GetWithContext(ctx context.Context, endpoint string, query url.
 	return c.do(req, v)
 }
 
-// NewClient creates a new service
-func NewClient(apiKey string) *Client {
+// NewClient creates a new service. baseURL points it at a WakaTime-compatible backend such as
+// Wakapi or Hakatime; an empty baseURL uses ApiUrl.
+func NewClient(apiKey, baseURL string) *Client {
+	if baseURL == "" {
+		baseURL = ApiUrl
+	}
+
 	return &Client{
 		apiKey:     apiKey,
-		origin:     ApiUrl,
+		origin:     strings.TrimRight(baseURL, "/") + "/",
 		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
 	}
 }
@@ -3,8 +3,22 @@ package wakatime
 import "testing"
 
 func TestNewClient_SetsTimeout(t *testing.T) {
-	c := NewClient("api-key")
+	c := NewClient("api-key", "")
 	if c.httpClient.Timeout == 0 {
 		t.Fatal("expected default timeout to be set")
 	}
 }
+
+func TestNewClient_NormalizesBaseURL(t *testing.T) {
+	tests := map[string]string{
+		"": ApiUrl,
+		"https://wakapi.example.com/api/compat/wakatime/v1":  "https://wakapi.example.com/api/compat/wakatime/v1/",
+		"https://wakapi.example.com/api/compat/wakatime/v1/": "https://wakapi.example.com/api/compat/wakatime/v1/",
+	}
+
+	for baseURL, want := range tests {
+		if got := NewClient("api-key", baseURL).origin; got != want {
+			t.Errorf("NewClient(%q) origin = %q, want %q", baseURL, got, want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package wakatime
+
+import (
+	"context"
+	"errors"
+	"log"
+)
+
+type GoalsService struct {
+	*Client
+	Logger *log.Logger
+}
+
+// GoalPeriod is a goal's progress within one day or week
+type GoalPeriod struct {
+	ActualSeconds     float64 `json:"actual_seconds"`
+	ActualSecondsText string  `json:"actual_seconds_text"`
+	GoalSeconds       float64 `json:"goal_seconds"`
+	GoalSecondsText   string  `json:"goal_seconds_text"`
+	RangeStatus       string  `json:"range_status"`
+	Range             struct {
+		Date  string `json:"date"`
+		Start string `json:"start"`
+		End   string `json:"end"`
+		Text  string `json:"text"`
+	} `json:"range"`
+}
+
+type Goal struct {
+	ID        string       `json:"id"`
+	Title     string       `json:"title"`
+	Status    string       `json:"status"`
+	Delta     string       `json:"delta"` // "day" or "week"
+	Seconds   float64      `json:"seconds"`
+	IsEnabled bool         `json:"is_enabled"`
+	IsSnoozed bool         `json:"is_snoozed"`
+	ChartData []GoalPeriod `json:"chart_data"`
+}
+
+type Goals struct {
+	Data  []Goal `json:"data"`
+	Total int    `json:"total"`
+}
+
+// Current returns the goal's latest period, or nil when WakaTime returned no periods
+func (g Goal) Current() *GoalPeriod {
+	if len(g.ChartData) == 0 {
+		return nil
+	}
+
+	return &g.ChartData[len(g.ChartData)-1]
+}
+
+// Streak returns the number of consecutive successful periods ending with the latest one.
+// A current period that has not succeeded yet is still running, so it does not break the streak.
+func (g Goal) Streak() int {
+	periods := g.ChartData
+	if n := len(periods); n > 0 && periods[n-1].RangeStatus != "success" {
+		periods = periods[:n-1]
+	}
+
+	streak := 0
+	for i := len(periods) - 1; i >= 0 && periods[i].RangeStatus == "success"; i-- {
+		streak++
+	}
+
+	return streak
+}
+
+// Get retrieves the user's coding goals with their progress in the recent periods
+func (s *GoalsService) Get(ctx context.Context) (*Goals, error) {
+	var goals Goals
+
+	err := s.GetWithContext(ctx, "users/current/goals", nil, &goals)
+	if err != nil {
+		var wakaTimeErr *WakaTimeError
+		if errors.As(err, &wakaTimeErr) && wakaTimeErr.IsNotCompleted() {
+			s.Logger.Println("WakaTime goals processing has not completed yet, please retry after a few minutes")
+
+			return nil, ErrStatsNotReady
+		}
+
+		return nil, err
+	}
+
+	return &goals, nil
+}
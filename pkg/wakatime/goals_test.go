@@ -0,0 +1,85 @@
+package wakatime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"testing"
+)
+
+func newTestGoalsService(status int, body string) *GoalsService {
+	client := NewClient("api-key", "")
+	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})
+
+	return &GoalsService{
+		Client: client,
+		Logger: log.New(io.Discard, "", 0),
+	}
+}
+
+func TestGoalsServiceGetDecodesGoals(t *testing.T) {
+	body := `{"data":[{"id":"g1","title":"Code 5 hrs per week","delta":"week","seconds":18000,"is_enabled":true,` +
+		`"chart_data":[{"actual_seconds":19000,"goal_seconds":18000,"range_status":"success"},` +
+		`{"actual_seconds":7200,"goal_seconds":18000,"range_status":"pending"}]}],"total":1}`
+	service := newTestGoalsService(http.StatusOK, body)
+
+	goals, err := service.Get(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(goals.Data) != 1 || goals.Data[0].Title != "Code 5 hrs per week" || goals.Data[0].Current().ActualSeconds != 7200 {
+		t.Fatalf("unexpected goals: %+v", goals)
+	}
+}
+
+func TestGoalsServiceGetReturnsNotReadyWhenAccepted(t *testing.T) {
+	service := newTestGoalsService(http.StatusAccepted, "")
+
+	_, err := service.Get(context.Background())
+
+	if !errors.Is(err, ErrStatsNotReady) {
+		t.Fatalf("expected ErrStatsNotReady, got %v", err)
+	}
+}
+
+func TestGoalStreak(t *testing.T) {
+	periods := func(statuses ...string) []GoalPeriod {
+		p := make([]GoalPeriod, len(statuses))
+		for i, s := range statuses {
+			p[i].RangeStatus = s
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		name      string
+		chartData []GoalPeriod
+		want      int
+	}{
+		{name: "no periods", want: 0},
+		{name: "running period does not break the streak", chartData: periods("fail", "success", "success", "pending"), want: 2},
+		{name: "succeeded period extends the streak", chartData: periods("success", "success", "success"), want: 3},
+		{name: "failed period ends the streak", chartData: periods("success", "fail", "success", "fail"), want: 1},
+		{name: "ignored period ends the streak", chartData: periods("success", "ignored", "pending"), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (Goal{ChartData: tt.chartData}).Streak(); got != tt.want {
+				t.Errorf("Streak() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
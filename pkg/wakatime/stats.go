@@ -25,13 +25,19 @@ type StatsItem struct {
 
 type Stats struct {
 	Data struct {
-		Status           string      `json:"status"`
-		Range            string      `json:"range"`
-		IsUpToDate       *bool       `json:"is_up_to_date"`
-		Languages        []StatsItem `json:"languages"`
-		Editors          []StatsItem `json:"editors"`
-		Projects         []StatsItem `json:"projects"`
-		OperatingSystems []StatsItem `json:"operating_systems"`
+		Status             string      `json:"status"`
+		Range              string      `json:"range"`
+		IsUpToDate         *bool       `json:"is_up_to_date"`
+		TotalSeconds       float64     `json:"total_seconds"`
+		HumanReadableTotal string      `json:"human_readable_total"`
+		Languages          []StatsItem `json:"languages"`
+		Editors            []StatsItem `json:"editors"`
+		Projects           []StatsItem `json:"projects"`
+		OperatingSystems   []StatsItem `json:"operating_systems"`
+		Categories         []StatsItem `json:"categories"`
+		Machines           []StatsItem `json:"machines"`
+		Dependencies       []StatsItem `json:"dependencies"`
+		Branches           []StatsItem `json:"branches"`
 
 		// AI attribution aggregates (top-level totals across the user's activity in this range).
 		AIAdditions       int64   `json:"ai_additions"`
@@ -102,6 +108,16 @@ func (s *StatsService) Get(ctx context.Context) (*Stats, error) {
 		return nil, err
 	}
 
+	// WakaTime-compatible backends such as Wakapi and Hakatime compute stats on request, so they
+	// may leave out status or name the range differently; treat those responses as ready for
+	// the requested range
+	if stats.Data.Status == "" {
+		stats.Data.Status = "ok"
+	}
+	if !StatsRange(stats.Data.Range).IsValid() {
+		stats.Data.Range = string(s.Range)
+	}
+
 	if stats.Data.Status == "pending_update" || stats.Data.IsUpToDate != nil && !*stats.Data.IsUpToDate {
 		s.Logger.Println("WakaTime stats are stale; please retry after a few minutes")
 
@@ -7,11 +7,12 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
 func newTestStatsService(status int, body string) *StatsService {
-	client := NewClient("api-key")
+	client := NewClient("api-key", "")
 	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
 		return &http.Response{
 			StatusCode: status,
@@ -53,3 +54,39 @@ func TestStatsServiceGetReturnsNotReadyWhenStatsAreStale(t *testing.T) {
 		t.Fatalf("expected ErrStatsNotReady, got %v", err)
 	}
 }
+
+func TestStatsServiceGetReturnsNotReadyWhenPendingUpdate(t *testing.T) {
+	service := newTestStatsService(http.StatusOK, `{"data":{"status":"pending_update","range":"last_7_days"}}`)
+
+	_, err := service.Get(context.Background())
+
+	if !errors.Is(err, ErrStatsNotReady) {
+		t.Fatalf("expected ErrStatsNotReady, got %v", err)
+	}
+}
+
+func TestStatsServiceGetAgainstCompatibleBackend(t *testing.T) {
+	var path, auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, auth = r.URL.Path, r.Header.Get("Authorization")
+		// Wakapi leaves out status and the AI attribution fields and labels the range itself
+		_, _ = w.Write([]byte(`{"data":{"range":"Last 7 Days","languages":[{"name":"Go","text":"1 hr","hours":1,"percent":100}]}}`))
+	}))
+	defer server.Close()
+
+	w := NewWakaTime(log.New(io.Discard, "", 0), "api-key", server.URL+"/api/compat/wakatime/v1/", StatsRangeLast7Days)
+	stats, err := w.Stats.Get(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if path != "/api/compat/wakatime/v1/users/current/stats/last_7_days" {
+		t.Errorf("unexpected request path: %s", path)
+	}
+	if auth != "Basic YXBpLWtleQ==" {
+		t.Errorf("unexpected authorization header: %s", auth)
+	}
+	if stats.Data.Status != "ok" || stats.Data.Range != "last_7_days" || stats.Data.Languages[0].Name != "Go" || stats.Data.AIAdditions != 0 {
+		t.Errorf("unexpected stats: %+v", stats.Data)
+	}
+}
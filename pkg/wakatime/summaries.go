@@ -0,0 +1,95 @@
+package wakatime
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/url"
+	"time"
+)
+
+// summaryDateLayout is the date format the summaries endpoint expects for start and end
+const summaryDateLayout = "2006-01-02"
+
+type SummariesService struct {
+	*Client
+	Logger *log.Logger
+}
+
+type GrandTotal struct {
+	Digital      string  `json:"digital"`
+	Text         string  `json:"text"`
+	Hours        int     `json:"hours"`
+	Minutes      int     `json:"minutes"`
+	TotalSeconds float64 `json:"total_seconds"`
+}
+
+// Summary is the user's coding activity for a single day
+type Summary struct {
+	GrandTotal GrandTotal  `json:"grand_total"`
+	Languages  []StatsItem `json:"languages"`
+	Projects   []StatsItem `json:"projects"`
+	Range      struct {
+		Date     string `json:"date"`
+		Start    string `json:"start"`
+		End      string `json:"end"`
+		Text     string `json:"text"`
+		Timezone string `json:"timezone"`
+	} `json:"range"`
+}
+
+// Day returns the calendar day this summary covers, or the zero time when the range is malformed.
+// Backends that leave out the range date, such as Wakapi, fall back to the date of the range start.
+func (s Summary) Day() time.Time {
+	if t, err := time.Parse(summaryDateLayout, s.Range.Date); err == nil {
+		return t
+	}
+
+	t, err := time.Parse(time.RFC3339, s.Range.Start)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+type Summaries struct {
+	Data            []Summary `json:"data"`
+	Start           string    `json:"start"`
+	End             string    `json:"end"`
+	CumulativeTotal struct {
+		Seconds float64 `json:"seconds"`
+		Text    string  `json:"text"`
+		Digital string  `json:"digital"`
+	} `json:"cumulative_total"`
+	DailyAverage struct {
+		Seconds int    `json:"seconds"`
+		Text    string `json:"text"`
+	} `json:"daily_average"`
+}
+
+// Get retrieves the user's coding activity for each day between start and end, both inclusive
+func (s *SummariesService) Get(ctx context.Context, start, end time.Time) (*Summaries, error) {
+	var summaries Summaries
+
+	query := url.Values{}
+	query.Set("start", start.Format(summaryDateLayout))
+	query.Set("end", end.Format(summaryDateLayout))
+	if tz := end.Location().String(); tz != "Local" {
+		query.Set("timezone", tz)
+	}
+
+	err := s.GetWithContext(ctx, "users/current/summaries", query, &summaries)
+	if err != nil {
+		var wakaTimeErr *WakaTimeError
+		if errors.As(err, &wakaTimeErr) && wakaTimeErr.IsNotCompleted() {
+			s.Logger.Println("WakaTime summaries processing has not completed yet, please retry after a few minutes")
+
+			return nil, ErrStatsNotReady
+		}
+
+		return nil, err
+	}
+
+	return &summaries, nil
+}
@@ -0,0 +1,81 @@
+package wakatime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func newTestSummariesService(status int, body string, query *url.Values) *SummariesService {
+	client := NewClient("api-key", "")
+	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if query != nil {
+			*query = req.URL.Query()
+		}
+
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})
+
+	return &SummariesService{
+		Client: client,
+		Logger: log.New(io.Discard, "", 0),
+	}
+}
+
+func TestSummariesServiceGetDecodesDays(t *testing.T) {
+	var query url.Values
+	body := `{"data":[{"grand_total":{"text":"2 hrs 32 mins","hours":2,"minutes":32,"total_seconds":9120},` +
+		`"languages":[{"name":"Go","text":"2 hrs","percent":80}],"projects":[{"name":"github-stats","percent":100}],` +
+		`"range":{"date":"2026-05-11"}}],"daily_average":{"seconds":9120,"text":"2 hrs 32 mins"}}`
+	service := newTestSummariesService(http.StatusOK, body, &query)
+
+	loc := time.FixedZone("Asia/Ho_Chi_Minh", 7*60*60)
+	s, err := service.Get(context.Background(), time.Date(2026, 5, 5, 0, 0, 0, 0, loc), time.Date(2026, 5, 11, 23, 0, 0, 0, loc))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query.Get("start") != "2026-05-05" || query.Get("end") != "2026-05-11" || query.Get("timezone") != "Asia/Ho_Chi_Minh" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	if len(s.Data) != 1 || s.Data[0].GrandTotal.TotalSeconds != 9120 || s.Data[0].Languages[0].Name != "Go" || s.Data[0].Projects[0].Name != "github-stats" {
+		t.Fatalf("unexpected summaries: %+v", s)
+	}
+	if day := s.Data[0].Day(); !day.Equal(time.Date(2026, 5, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected day: %v", day)
+	}
+}
+
+func TestSummariesServiceGetReturnsNotReadyWhenAccepted(t *testing.T) {
+	service := newTestSummariesService(http.StatusAccepted, "", nil)
+
+	_, err := service.Get(context.Background(), time.Now(), time.Now())
+
+	if !errors.Is(err, ErrStatsNotReady) {
+		t.Fatalf("expected ErrStatsNotReady, got %v", err)
+	}
+}
+
+func TestSummaryDayFallsBackToRangeStart(t *testing.T) {
+	var s Summary
+	s.Range.Start = "2026-05-11T00:00:00+07:00"
+
+	if day := s.Day(); !day.Equal(time.Date(2026, 5, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected day: %v", day)
+	}
+
+	if day := (Summary{}).Day(); !day.IsZero() {
+		t.Errorf("expected zero day without a range, got %v", day)
+	}
+}
@@ -3,11 +3,13 @@ package wakatime
 import "log"
 
 type WakaTime struct {
-	Stats *StatsService
+	Stats     *StatsService
+	Summaries *SummariesService
+	Goals     *GoalsService
 }
 
-// NewWakaTime creates a new WakaTime
-func NewWakaTime(logger *log.Logger, apiKey string, statsRange StatsRange) *WakaTime {
+// NewWakaTime creates a new WakaTime talking to baseURL, or to ApiUrl when it is empty
+func NewWakaTime(logger *log.Logger, apiKey, baseURL string, statsRange StatsRange) *WakaTime {
 	if apiKey == "" {
 		return nil
 	}
@@ -16,9 +18,11 @@ func NewWakaTime(logger *log.Logger, apiKey string, statsRange StatsRange) *Waka
 		statsRange = StatsRangeLast7Days
 	}
 
-	client := NewClient(apiKey)
+	client := NewClient(apiKey, baseURL)
 
 	return &WakaTime{
-		Stats: &StatsService{client, logger, statsRange},
+		Stats:     &StatsService{client, logger, statsRange},
+		Summaries: &SummariesService{client, logger},
+		Goals:     &GoalsService{client, logger},
 	}
 }
@@ -0,0 +1,82 @@
+package writer
+
+import (
+	"time"
+
+	"github.com/thanhhaudev/github-stats/pkg/github"
+)
+
+// calendarWeeks is the number of week columns in the commit calendar: 52 full weeks plus the current one
+const calendarWeeks = 53
+
+// MakeCommitCalendar returns a GitHub-style heatmap of the commits over the last year
+func MakeCommitCalendar(commits []github.Commit, now time.Time) string {
+	return makeBlock(CommitCalendarCard(commits, now), "")
+}
+
+// CommitCalendarCard returns the commits per day over the last year as a heatmap Card.
+// Columns are weeks starting on Sunday, rows are weekdays; the last column holds today.
+func CommitCalendarCard(commits []github.Commit, now time.Time) *Card {
+	loc := now.Location()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	start := today.AddDate(0, 0, -int(today.Weekday())-(calendarWeeks-1)*7)
+
+	total := 0
+	counts := make(map[string]int)
+	for _, c := range commits {
+		day := c.CommittedDate.In(loc)
+		day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+		if day.Before(start) || day.After(today) {
+			continue
+		}
+
+		counts[day.Format(time.DateOnly)]++
+		total++
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	l := currentLocale()
+	g := &Grid{
+		Rows:    []string{"", l.ShortWeekdays[time.Monday], "", l.ShortWeekdays[time.Wednesday], "", l.ShortWeekdays[time.Friday], ""},
+		Columns: make([]string, calendarWeeks),
+		Cells:   make([][]int, 7),
+	}
+	for row := range g.Cells {
+		g.Cells[row] = make([]int, calendarWeeks)
+	}
+
+	prev := -1
+	for col := 0; col < calendarWeeks; col++ {
+		week := start.AddDate(0, 0, col*7)
+		for row := 0; row < 7; row++ {
+			day := week.AddDate(0, 0, row)
+			if day.After(today) {
+				g.Cells[row][col] = -1
+				continue
+			}
+
+			v := counts[day.Format(time.DateOnly)]
+			g.Cells[row][col] = v
+			g.Max = max(g.Max, v)
+		}
+
+		// label the first week of each month, dropping a partial first month that would overlap
+		if col == 0 || week.Month() != week.AddDate(0, 0, -7).Month() {
+			if prev == 0 && col < 4 {
+				g.Columns[0] = ""
+			}
+
+			g.Columns[col] = l.ShortMonths[week.Month()-1]
+			prev = col
+		}
+	}
+
+	return &Card{
+		Title:    tr("calendar.title", formatUnit(int64(total), "commitTitle")),
+		Grid:     g,
+		Headline: formatUnit(int64(total), "commit"),
+	}
+}
@@ -0,0 +1,144 @@
+package writer
+
+import "strings"
+
+// Card is the renderer-neutral form of a metric: a title and the rows shown under it.
+// The Markdown text blocks and the SVG cards are both rendered from the same Card.
+type Card struct {
+	Title    string
+	Groups   []Group
+	Grid     *Grid  // set for heatmap metrics, which have no rows
+	Headline string // the metric's key figure, such as the total time; empty when it has none
+}
+
+// Group is a run of rows inside a Card. Label is empty for single-group cards;
+// Stats marks label/value rows that are rendered without a progress bar.
+type Group struct {
+	Label string
+	Rows  []Data
+	Stats bool
+}
+
+// Grid is a matrix of counts drawn as a heatmap. Empty row and column labels are not drawn.
+type Grid struct {
+	Rows    []string // row labels, top to bottom
+	Columns []string // column labels, left to right
+	Cells   [][]int  // Cells[row][col]; negative for cells outside the covered range
+	Max     int      // largest cell value, the top of the intensity scale
+	Circles bool     // SVG cards draw circles sized by intensity instead of squares
+}
+
+// heatLevels is the number of intensity buckets above zero
+const heatLevels = 4
+
+var heatGlyphs = []string{"·", "░", "▒", "▓", "█"}
+
+// Level returns the intensity bucket of v, from 0 (no activity) to heatLevels
+func (g *Grid) Level(v int) int {
+	if v <= 0 || g.Max <= 0 {
+		return 0
+	}
+
+	return min(heatLevels, (v*heatLevels+g.Max-1)/g.Max)
+}
+
+// makeBlock renders a Card as a bold title followed by a fixed-width code block
+func makeBlock(c *Card, version string) string {
+	if c == nil {
+		return ""
+	}
+
+	if c.Grid != nil {
+		return makeGridBlock(c.Title, c.Grid)
+	}
+
+	if len(c.Groups) == 1 && c.Groups[0].Stats {
+		lines := make([]string, 0, len(c.Groups[0].Rows))
+		for _, r := range c.Groups[0].Rows {
+			lines = append(lines, formatStatLine(r.Name, r.Description))
+		}
+
+		return makeStatBlock(c.Title, lines...)
+	}
+
+	var b strings.Builder
+	b.WriteString("**")
+	b.WriteString(c.Title)
+	b.WriteString("**\n\n```text")
+	for i, g := range c.Groups {
+		if i > 0 {
+			b.WriteString("\n") // blank line between groups
+		}
+
+		if g.Label != "" {
+			b.WriteString("\n")
+			b.WriteString(g.Label)
+		}
+
+		if len(g.Rows) == 0 {
+			b.WriteString("\n" + tr("noData"))
+			continue
+		}
+
+		for _, r := range g.Rows {
+			if g.Stats {
+				b.WriteString("\n")
+				b.WriteString(strings.TrimSuffix(formatStatLine(r.Name, r.Description), "\n"))
+				continue
+			}
+
+			b.WriteString(formatData(r, version))
+		}
+	}
+	b.WriteString("\n```\n\n")
+
+	return b.String()
+}
+
+// makeGridBlock renders a Grid as rows of shaded glyphs under their column labels
+func makeGridBlock(title string, g *Grid) string {
+	labelWidth := 0
+	for _, r := range g.Rows {
+		labelWidth = max(labelWidth, displayWidth(r)+1)
+	}
+
+	var b strings.Builder
+	b.WriteString("**")
+	b.WriteString(title)
+	b.WriteString("**\n\n```text\n")
+
+	// place each column label above its column by display width, skipping labels
+	// that would run into the previous one (long month names in some locales)
+	header, width := strings.Repeat(" ", labelWidth), 0
+	for i, l := range g.Columns {
+		if l == "" || width > 0 && i <= width {
+			continue
+		}
+
+		header += strings.Repeat(" ", i-width) + l
+		width = i + displayWidth(l)
+	}
+	b.WriteString(strings.TrimRight(header, " "))
+	b.WriteString("\n")
+
+	for i, row := range g.Cells {
+		line := g.Rows[i] + strings.Repeat(" ", labelWidth-displayWidth(g.Rows[i]))
+		for _, v := range row {
+			if v < 0 {
+				line += " "
+				continue
+			}
+
+			line += heatGlyphs[g.Level(v)]
+		}
+		b.WriteString(strings.TrimRight(line, " "))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(strings.Repeat(" ", labelWidth))
+	b.WriteString(tr("calendar.less") + " " + strings.Join(heatGlyphs, "") + " " + tr("calendar.more") + "\n")
+	b.WriteString("```\n\n")
+
+	return b.String()
+}
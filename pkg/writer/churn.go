@@ -0,0 +1,99 @@
+package writer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thanhhaudev/github-stats/pkg/github"
+)
+
+// churnMonths is the number of months in the per-month CODE_CHURN breakdown
+const churnMonths = 12
+
+// MakeCodeChurnList returns the lines added and deleted, with a per-year or per-month breakdown
+func MakeCodeChurnList(commits []github.Commit, byMonth bool, now time.Time, version string) string {
+	return makeBlock(CodeChurnCard(commits, byMonth, now), version)
+}
+
+// CodeChurnCard returns the line totals of the commits as a Card. The breakdown
+// covers every year, or with byMonth the last churnMonths months up to now.
+// Bars show each period's share of all changed lines in the breakdown.
+func CodeChurnCard(commits []github.Commit, byMonth bool, now time.Time) *Card {
+	if len(commits) == 0 {
+		return nil
+	}
+
+	var added, deleted int64
+	first, last := commits[0].CommittedDate.Year(), commits[0].CommittedDate.Year()
+	periods := make(map[string][2]int64)
+	for _, c := range commits {
+		added += int64(c.Additions)
+		deleted += int64(c.Deletions)
+
+		key := c.CommittedDate.Format("2006")
+		if byMonth {
+			key = c.CommittedDate.Format("2006-01")
+		}
+
+		p := periods[key]
+		periods[key] = [2]int64{p[0] + int64(c.Additions), p[1] + int64(c.Deletions)}
+		first = min(first, c.CommittedDate.Year())
+		last = max(last, c.CommittedDate.Year())
+	}
+
+	var keys []string
+	label := tr("churn.byYear")
+	if byMonth {
+		label = tr("churn.byMonth")
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -(churnMonths - 1), 0)
+		for i := 0; i < churnMonths; i++ {
+			keys = append(keys, start.AddDate(0, i, 0).Format("2006-01"))
+		}
+	} else {
+		for y := first; y <= last; y++ {
+			keys = append(keys, fmt.Sprint(y))
+		}
+	}
+
+	var changed int64
+	for _, k := range keys {
+		changed += periods[k][0] + periods[k][1]
+	}
+
+	var rows []Data
+	for _, k := range keys {
+		p := periods[k]
+		var percent float64
+		if changed > 0 {
+			percent = float64(p[0]+p[1]) / float64(changed) * 100
+		}
+
+		rows = append(rows, Data{
+			Name:        k,
+			Description: "+" + humanizeCount(p[0]) + " / -" + humanizeCount(p[1]),
+			Percent:     percent,
+			Count:       int(p[0] + p[1]),
+		})
+	}
+
+	net := "+" + formatNumber(int(added-deleted))
+	if added < deleted {
+		net = "-" + formatNumber(int(deleted-added))
+	}
+
+	return &Card{
+		Title: tr("churn.title"),
+		Groups: []Group{
+			{
+				Rows: []Data{
+					{Name: tr("churn.added"), Description: formatUnit(added, "line")},
+					{Name: tr("churn.deleted"), Description: formatUnit(deleted, "line")},
+					{Name: tr("churn.net"), Description: tr("churn.netDiff", net)},
+					{Name: tr("churn.average"), Description: formatUnit((added+deleted)/int64(len(commits)), "line")},
+				},
+				Stats: true,
+			},
+			{Label: label, Rows: rows},
+		},
+	}
+}
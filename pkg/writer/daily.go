@@ -0,0 +1,74 @@
+package writer
+
+import (
+	"github.com/thanhhaudev/github-stats/pkg/wakatime"
+)
+
+// MakeWakaDailyList returns the WakaTime coding time of each day in the summaries
+func MakeWakaDailyList(s *wakatime.Summaries, version string) string {
+	return makeBlock(WakaDailyCard(s), version)
+}
+
+// WakaDailyCard returns the coding time of each day in the summaries as a Card. The first
+// group holds the best day, the daily average and the total; the second one row per day,
+// oldest first, with Count in minutes for the charts. It is nil when nothing was coded.
+func WakaDailyCard(s *wakatime.Summaries) *Card {
+	if s == nil || len(s.Data) == 0 {
+		return nil
+	}
+
+	var total float64
+	for _, d := range s.Data {
+		total += d.GrandTotal.TotalSeconds
+	}
+
+	if total <= 0 {
+		return nil
+	}
+
+	rows := make([]Data, len(s.Data))
+	best := 0
+	for i, d := range s.Data {
+		name := d.Range.Date
+		if day := d.Day(); !day.IsZero() {
+			name = formatDate(day)
+		}
+
+		seconds := d.GrandTotal.TotalSeconds
+		rows[i] = Data{
+			Name:        name,
+			Description: formatSeconds(seconds),
+			Percent:     seconds / total * 100,
+			Count:       int(seconds / 60),
+		}
+		if seconds > s.Data[best].GrandTotal.TotalSeconds {
+			best = i
+		}
+	}
+
+	return &Card{
+		Title:    tr("daily.title", len(s.Data)),
+		Headline: formatSeconds(total),
+		Groups: []Group{
+			{
+				Rows: []Data{
+					{Name: tr("daily.best"), Description: tr("daily.when", rows[best].Description, rows[best].Name)},
+					{Name: tr("daily.average"), Description: formatSeconds(total / float64(len(s.Data)))},
+					{Name: tr("daily.total"), Description: formatSeconds(total)},
+				},
+				Stats: true,
+			},
+			{Rows: rows},
+		},
+	}
+}
+
+// formatSeconds returns seconds as whole hours and minutes, such as "2 hrs 5 mins"
+func formatSeconds(seconds float64) string {
+	minutes := int(seconds / 60)
+	if minutes == 0 {
+		return formatUnit(0, "minute")
+	}
+
+	return formatTime(minutes/60, minutes%60)
+}
@@ -1,43 +0,0 @@
-package writer
-
-var longWeekTimeNames = []string{
-	"Morning",
-	"Daytime",
-	"Evening",
-	"Night",
-}
-
-var timesOfDayEmoji = []string{
-	"🌅",
-	"🌞",
-	"🌆",
-	"🌙",
-}
-
-var longTimesOfDayStatuses = []string{
-	"An Early Bird 🐤",
-	"An Afternoon Warrior 🥷🏻",
-	"A Twilight Taskmaster 🌆",
-	"A Night Owl 🦉",
-}
-
-var timesOfDayStatuses = []string{
-	"An Early 🐤",
-	"A Night 🦉",
-}
-
-var wakaRangeNames = map[string]string{
-	"last_7_days":   "📅 Last 7 Days Stats",
-	"last_30_days":  "📊 Last 30 Days Stats",
-	"last_6_months": "📈 Last 6 Months Stats",
-	"last_year":     "🗓️ Last 12 Months Stats",
-	"all_time":      "⏱️ All Time Stats",
-}
-
-var aiFootprintTitles = map[string]string{
-	"last_7_days":   "🤖 My Week in AI",
-	"last_30_days":  "🤖 My Month in AI",
-	"last_6_months": "🤖 My 6 Months in AI",
-	"last_year":     "🤖 My Year in AI",
-	"all_time":      "🤖 My AI Footprint",
-}
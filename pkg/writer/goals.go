@@ -0,0 +1,66 @@
+package writer
+
+import (
+	"fmt"
+
+	"github.com/thanhhaudev/github-stats/pkg/wakatime"
+)
+
+// MakeWakaGoalsList returns the progress of each active WakaTime goal in its current period
+func MakeWakaGoalsList(g *wakatime.Goals, version string) string {
+	return makeBlock(WakaGoalsCard(g), version)
+}
+
+// WakaGoalsCard returns the enabled, not snoozed WakaTime goals as a Card. The first group
+// holds one row per goal with its current-period progress against the target; the second
+// the number of consecutive days or weeks each goal was met. It is nil without active goals.
+func WakaGoalsCard(g *wakatime.Goals) *Card {
+	if g == nil {
+		return nil
+	}
+
+	var progress, streaks []Data
+	for _, goal := range g.Data {
+		p := goal.Current()
+		if !goal.IsEnabled || goal.IsSnoozed || p == nil {
+			continue
+		}
+
+		var percent float64
+		if p.GoalSeconds > 0 {
+			percent = p.ActualSeconds / p.GoalSeconds * 100
+		}
+
+		progress = append(progress, Data{
+			Name:        goal.Title,
+			Description: tr("goals.of", formatDigital(p.ActualSeconds), formatDigital(p.GoalSeconds)),
+			Percent:     percent,
+			Fill:        min(percent, 100),
+		})
+
+		unit := "day"
+		if goal.Delta == "week" {
+			unit = "week"
+		}
+		streaks = append(streaks, Data{Name: goal.Title, Description: formatUnit(int64(goal.Streak()), unit)})
+	}
+
+	if len(progress) == 0 {
+		return nil
+	}
+
+	return &Card{
+		Title: tr("goals.title"),
+		Groups: []Group{
+			{Rows: progress},
+			{Label: tr("goals.streaks"), Rows: streaks, Stats: true},
+		},
+	}
+}
+
+// formatDigital returns seconds as hours and minutes on a clock, such as "1:05"
+func formatDigital(seconds float64) string {
+	minutes := int(seconds / 60)
+
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,171 @@
+package writer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/thanhhaudev/github-stats/pkg/github"
+)
+
+// DayPeriod is a named range of hours used by COMMIT_TIMES_OF_DAY.
+// End is exclusive; a period with End before Start runs across midnight.
+type DayPeriod struct {
+	Start  int
+	End    int
+	Name   string
+	Emoji  string // optional
+	Status string // optional, shown in the title when the period has the most commits
+}
+
+// Contains reports whether hour falls in the period
+func (p DayPeriod) Contains(hour int) bool {
+	if p.Start < p.End {
+		return hour >= p.Start && hour < p.End
+	}
+
+	return hour >= p.Start || hour < p.End // across midnight
+}
+
+// Early reports whether the middle of the period falls between 06:00 and 18:00,
+// the early half of the day in the simplified COMMIT_TIMES_OF_DAY title
+func (p DayPeriod) Early() bool {
+	length := (p.End - p.Start + 24) % 24
+	if length == 0 {
+		length = 24
+	}
+
+	mid := math.Mod(float64(p.Start)+float64(length)/2, 24)
+
+	return mid >= 6 && mid < 18
+}
+
+// DefaultDayPeriods returns the built-in periods: Morning 6–12, Daytime 12–18, Evening 18–23 and Night 23–6
+func DefaultDayPeriods() []DayPeriod {
+	bounds := [][2]int{{6, 12}, {12, 18}, {18, 23}, {23, 6}}
+	periods := make([]DayPeriod, len(weekTimeKeys))
+	for i, k := range weekTimeKeys {
+		periods[i] = DayPeriod{
+			Start:  bounds[i][0],
+			End:    bounds[i][1],
+			Name:   WeekTime(i).String(),
+			Emoji:  timesOfDayEmoji[i],
+			Status: tr("times.status." + k),
+		}
+	}
+
+	return periods
+}
+
+// ParseDayPeriods parses ";"-separated "start-end|name|emoji|status" entries, where start
+// and end are hours from 0 to 24 and the emoji and status are optional. Every hour must
+// belong to exactly one period.
+func ParseDayPeriods(def string) ([]DayPeriod, error) {
+	var (
+		periods []DayPeriod
+		taken   [24]bool
+	)
+	for _, e := range strings.Split(def, ";") {
+		if strings.TrimSpace(e) == "" {
+			continue
+		}
+
+		parts := strings.Split(e, "|")
+		if len(parts) > 4 {
+			return nil, fmt.Errorf("period %q has more than 4 fields", e)
+		}
+
+		for len(parts) < 4 {
+			parts = append(parts, "")
+		}
+
+		from, to, ok := strings.Cut(strings.TrimSpace(parts[0]), "-")
+		start, err1 := strconv.Atoi(strings.TrimSpace(from))
+		end, err2 := strconv.Atoi(strings.TrimSpace(to))
+		if !ok || err1 != nil || err2 != nil || start < 0 || start > 23 || end < 0 || end > 24 || start == end {
+			return nil, fmt.Errorf("period %q must start with a range of hours like 6-12", e)
+		}
+
+		p := DayPeriod{
+			Start:  start,
+			End:    end % 24,
+			Name:   strings.TrimSpace(parts[1]),
+			Emoji:  strings.TrimSpace(parts[2]),
+			Status: strings.TrimSpace(parts[3]),
+		}
+		if p.Name == "" {
+			return nil, fmt.Errorf("period %q must have a name", e)
+		}
+
+		for h := range taken {
+			if !p.Contains(h) {
+				continue
+			}
+
+			if taken[h] {
+				return nil, fmt.Errorf("period %q overlaps another period at %02d:00", e, h)
+			}
+			taken[h] = true
+		}
+
+		periods = append(periods, p)
+	}
+
+	if len(periods) == 0 {
+		return nil, fmt.Errorf("no periods defined")
+	}
+
+	for h, ok := range taken {
+		if !ok {
+			return nil, fmt.Errorf("periods must cover the whole day, %02d:00 is not in any period", h)
+		}
+	}
+
+	return periods, nil
+}
+
+// MakeCommitHoursList returns a histogram of the commits made in each hour of the day
+func MakeCommitHoursList(commits []github.Commit, version string) string {
+	return makeBlock(CommitHoursCard(commits), version)
+}
+
+// CommitHoursCard returns the commits per hour of the day as a Card of 24 rows, midnight first.
+// Percent is the share of all commits; the bars are relative to the busiest hour.
+func CommitHoursCard(commits []github.Commit) *Card {
+	if len(commits) == 0 {
+		return nil
+	}
+
+	var counts [24]int
+	for _, c := range commits {
+		counts[c.CommittedDate.Hour()]++
+	}
+
+	top := 0
+	for h, n := range counts {
+		if n > counts[top] {
+			top = h
+		}
+	}
+
+	data := make([]Data, 0, len(counts))
+	for h, n := range counts {
+		data = append(data, Data{
+			Name:        formatHour(h),
+			Description: formatUnit(int64(n), "commit"),
+			Percent:     float64(n) / float64(len(commits)) * 100,
+			Count:       n,
+			Fill:        float64(n) / float64(counts[top]) * 100,
+		})
+	}
+
+	return &Card{
+		Title:  tr("hours.title", formatHour(top)),
+		Groups: []Group{{Rows: data}},
+	}
+}
+
+func formatHour(h int) string {
+	return fmt.Sprintf("%02d:00", h)
+}
@@ -0,0 +1,68 @@
+package writer
+
+import (
+	"html"
+	"strings"
+)
+
+// Placeholders replaced in a Layout summary
+const (
+	SummaryTitle = "{title}" // the metric title
+	SummaryValue = "{value}" // the card's Headline, empty for metrics without one
+)
+
+// Layout controls how a rendered metric is presented around its content
+type Layout struct {
+	Heading int    // 1–6 renders the title as a Markdown heading; 0 keeps the bold title line
+	Details bool   // wrap the metric in a collapsible <details> element
+	Summary string // <summary> text, may contain SummaryTitle and SummaryValue; empty uses the title itself
+}
+
+// ApplyLayout presents a rendered metric block of card c according to l.
+// A collapsed metric shows its title as the summary, unless a custom summary is set,
+// in which case the title stays inside the collapsed content.
+func ApplyLayout(block string, c *Card, l Layout) string {
+	if block == "" || l == (Layout{}) {
+		return block
+	}
+
+	title := c.Title
+	body, hasTitle := strings.CutPrefix(block, "**"+title+"**\n\n")
+	if !hasTitle {
+		body = block
+	}
+
+	if !l.Details {
+		if !hasTitle || l.Heading == 0 {
+			return block
+		}
+
+		return makeHeading(title, l.Heading) + body
+	}
+
+	summary := title
+	if l.Summary != "" {
+		summary = strings.NewReplacer(SummaryTitle, title, SummaryValue, c.Headline).Replace(l.Summary)
+		if hasTitle {
+			body = makeHeading(title, l.Heading) + body
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("<details>\n<summary>")
+	b.WriteString(html.EscapeString(summary))
+	b.WriteString("</summary>\n\n")
+	b.WriteString(body)
+	b.WriteString("</details>\n\n")
+
+	return b.String()
+}
+
+// makeHeading returns the title line of a block: a Markdown heading of the given level, or bold text for 0
+func makeHeading(title string, level int) string {
+	if level == 0 {
+		return "**" + title + "**\n\n"
+	}
+
+	return strings.Repeat("#", level) + " " + title + "\n\n"
+}
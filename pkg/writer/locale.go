@@ -0,0 +1,150 @@
+package writer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLocale is the locale used when LOCALE is not set
+const DefaultLocale = "en"
+
+// Locale holds the message catalog and the formatting rules of a language
+type Locale struct {
+	Messages map[string]string // message key → fmt format
+	// Units maps a unit key to its plural forms, each a fmt format for the formatted number.
+	// Plural picks the form for a count.
+	Units  map[string][]string
+	Plural func(n int64) int
+
+	Weekdays      [7]string  // indexed by time.Weekday
+	ShortWeekdays [7]string  // calendar row labels
+	ShortMonths   [12]string // calendar column labels, January first
+
+	GroupSeparator   string // thousands separator
+	DecimalSeparator string
+}
+
+var locales = map[string]*Locale{
+	"en": &localeEN,
+	"vi": &localeVI,
+	"ja": &localeJA,
+}
+
+var (
+	localeMu sync.RWMutex
+	locale   = locales[DefaultLocale]
+)
+
+// SetLocale selects the locale used for every rendered label, title and number
+func SetLocale(code string) error {
+	l, ok := locales[code]
+	if !ok {
+		return fmt.Errorf("unknown locale %q, available: %s", code, strings.Join(Locales(), ", "))
+	}
+
+	localeMu.Lock()
+	locale = l
+	localeMu.Unlock()
+
+	return nil
+}
+
+// currentLocale returns the locale selected by SetLocale
+func currentLocale() *Locale {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+
+	return locale
+}
+
+// Locales returns the available locale codes
+func Locales() []string {
+	codes := make([]string, 0, len(locales))
+	for code := range locales {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	return codes
+}
+
+// tr returns the localized message for key, formatted with args. Messages missing
+// from the current locale fall back to English.
+func tr(key string, args ...any) string {
+	msg, ok := currentLocale().Messages[key]
+	if !ok {
+		msg = localeEN.Messages[key]
+	}
+
+	if len(args) == 0 {
+		return msg
+	}
+
+	return fmt.Sprintf(msg, args...)
+}
+
+// formatUnit formats value with the plural form of unit, e.g. "1,234 commits"
+func formatUnit(value int64, unit string) string {
+	return formatUnitFormatted(value, unit, func(n int64) string {
+		return formatNumber(int(n))
+	})
+}
+
+// formatUnitFormatted is formatUnit with a custom number format, e.g. humanizeCount
+func formatUnitFormatted(value int64, unit string, format func(int64) string) string {
+	l := currentLocale()
+	forms, ok := l.Units[unit]
+	if !ok {
+		l = &localeEN
+		forms = l.Units[unit]
+	}
+
+	return fmt.Sprintf(forms[min(l.Plural(value), len(forms)-1)], format(value))
+}
+
+// formatNumber groups the digits of n with the locale's thousands separator
+func formatNumber(n int) string {
+	str := fmt.Sprintf("%d", n)
+	sign := ""
+	if n < 0 {
+		sign, str = "-", str[1:]
+	}
+
+	sep := currentLocale().GroupSeparator
+	var b strings.Builder
+	for i, c := range str {
+		if (len(str)-i)%3 == 0 && i != 0 {
+			b.WriteString(sep)
+		}
+		b.WriteRune(c)
+	}
+
+	return sign + b.String()
+}
+
+// formatDecimal formats v with a fmt verb such as "%.1f" and the locale's decimal separator
+func formatDecimal(format string, v float64) string {
+	return strings.Replace(fmt.Sprintf(format, v), ".", currentLocale().DecimalSeparator, 1)
+}
+
+// weekdayName returns the localized name of a weekday
+func weekdayName(d time.Weekday) string {
+	return currentLocale().Weekdays[d]
+}
+
+// englishPlural is the plural rule of English: one, other
+func englishPlural(n int64) int {
+	if n == 1 {
+		return 0
+	}
+
+	return 1
+}
+
+// noPlural is the plural rule of languages without plural forms, such as Vietnamese and Japanese
+func noPlural(int64) int {
+	return 0
+}
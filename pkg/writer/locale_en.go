@@ -0,0 +1,154 @@
+package writer
+
+var localeEN = Locale{
+	Messages: map[string]string{
+		"noData":      "No data available",
+		"lastUpdated": "⏳ *Last updated on %s*",
+
+		"languages.title": "💬 Languages & Tools",
+
+		"waka.last_7_days":   "📅 Last 7 Days Stats",
+		"waka.last_30_days":  "📊 Last 30 Days Stats",
+		"waka.last_6_months": "📈 Last 6 Months Stats",
+		"waka.last_year":     "🗓️ Last 12 Months Stats",
+		"waka.all_time":      "⏱️ All Time Stats",
+		"waka.languages":     "💬 Languages:",
+		"waka.editors":       "📝 Editors:",
+		"waka.os":            "💻 Operating Systems:",
+		"waka.projects":      "📦 Projects:",
+		"waka.categories":    "🏷️ Categories:",
+		"waka.machines":      "🖥️ Machines:",
+		"waka.dependencies":  "🧩 Dependencies:",
+		"waka.branches":      "🌿 Branches:",
+		"waka.others":        "Others",
+
+		"streak.title":        "📈 Coding Streak",
+		"streak.current":      "🔥 Current Streak:",
+		"streak.longest":      "🏆 Longest Streak:",
+		"streak.dailyAverage": "📊 Daily Average:",
+		"streak.totalTime":    "💪 Total Coding Time:",
+		"streak.consistency":  "🎯 Coding Consistency:",
+		"streak.activeDays":   "📅 Active Days:",
+		"streak.hoursMinutes": "%d hrs %d mins",
+		"streak.lastCommit":   "🕒 Last Commit:",
+		"streak.today":        "today",
+		"streak.ago":          "%s ago",
+		"streak.longestGap":   "💤 Longest Gap:",
+		"streak.top":          "Top Streaks",
+		"streak.rank":         "%d. %s",
+
+		"ai.last_7_days":   "🤖 My Week in AI",
+		"ai.last_30_days":  "🤖 My Month in AI",
+		"ai.last_6_months": "🤖 My 6 Months in AI",
+		"ai.last_year":     "🤖 My Year in AI",
+		"ai.all_time":      "🤖 My AI Footprint",
+		"ai.generated":     "🤖 Generated by AI:",
+		"ai.handwritten":   "👤 Written by Hand:",
+		"ai.contribution":  "📊 AI Contribution:",
+		"ai.tokens":        "🔤 Tokens In / Out:",
+		"ai.avgPrompt":     "💬 Average Prompt:",
+
+		"times.title":          "🕒 I'm %s",
+		"times.activeTitle":    "🕒 Most Active in the %s",
+		"times.morning":        "Morning",
+		"times.daytime":        "Daytime",
+		"times.evening":        "Evening",
+		"times.night":          "Night",
+		"times.status.morning": "An Early Bird 🐤",
+		"times.status.daytime": "An Afternoon Warrior 🥷🏻",
+		"times.status.evening": "A Twilight Taskmaster 🌆",
+		"times.status.night":   "A Night Owl 🦉",
+		"times.status.early":   "An Early 🐤",
+		"times.status.late":    "A Night 🦉",
+
+		"hours.title": "⏰ Busiest Hour: %s",
+
+		"days.title": "📅 I'm Most Productive on %s",
+		"repo.title": "🔥 I Mostly Code in %s",
+
+		"top.title":       "🏆 Top Repositories",
+		"top.description": "%s · %s",
+		"top.columns":     "Commits · Lines Changed",
+		"top.private":     "🔒 Private repos",
+
+		"calendar.title": "📆 %s in the Last Year",
+		"calendar.less":  "Less",
+		"calendar.more":  "More",
+
+		"punch.title": "🕰️ Most Commits on %s at %s",
+
+		"profile.title":       "👤 Profile Summary",
+		"profile.joined":      "🎂 Joined GitHub:",
+		"profile.age":         "🎉 Account Age:",
+		"profile.yearsMonths": "%s, %s",
+		"profile.repos":       "📦 Repositories:",
+		"profile.reposSplit":  "%s owned / %s contributed",
+		"profile.firstCommit": "🌱 First Commit:",
+		"profile.commits":     "💾 Total Commits:",
+		"profile.activeDays":  "📅 Active Days:",
+		"profile.average":     "📊 Commits per Day:",
+
+		"date":  "%[1]s %[2]d, %[3]d",
+		"month": "%[1]s %[2]d",
+
+		"periods.year":    "📈 Commits per Year",
+		"periods.quarter": "📈 Commits per Quarter",
+
+		"churn.title":   "📝 Code Churn",
+		"churn.added":   "➕ Lines Added:",
+		"churn.deleted": "➖ Lines Deleted:",
+		"churn.net":     "📊 Net Change:",
+		"churn.average": "📏 Average Commit:",
+		"churn.netDiff": "%s lines",
+		"churn.byYear":  "By Year",
+		"churn.byMonth": "By Month",
+
+		"sizes.title":  "📦 Commit Sizes",
+		"sizes.median": "📏 Median Commit:",
+		"sizes.p90":    "📐 90th Percentile:",
+		"sizes.bySize": "By Lines Changed",
+
+		"trend.months":   "📈 Commit Trend, Last %d Months",
+		"trend.weeks":    "📈 Commit Trend, Last %d Weeks",
+		"trend.lowest":   "📉 Lowest:",
+		"trend.highest":  "📈 Highest:",
+		"trend.average":  "📊 Average:",
+		"trend.when":     "%s (%s)",
+		"trend.perMonth": "%s commits per month",
+		"trend.perWeek":  "%s commits per week",
+
+		"daily.title":   "⌨️ Daily Coding Time, Last %d Days",
+		"daily.best":    "🏆 Best Day:",
+		"daily.average": "📊 Daily Average:",
+		"daily.total":   "💪 Total Coding Time:",
+		"daily.when":    "%s (%s)",
+
+		"goals.title":   "🎯 Coding Goals",
+		"goals.streaks": "🔥 Success Streaks",
+		"goals.of":      "%s / %s",
+
+		"table.name":    "Name",
+		"table.value":   "Value",
+		"table.bar":     "Progress",
+		"table.percent": "Percent",
+	},
+	Units: map[string][]string{
+		"commit":      {"%s commit", "%s commits"},
+		"commitTitle": {"%s Commit", "%s Commits"},
+		"repo":        {"%s repo", "%s repos"},
+		"day":         {"%s day", "%s days"},
+		"line":        {"%s line", "%s lines"},
+		"char":        {"%s char", "%s chars"},
+		"hour":        {"%s hr", "%s hrs"},
+		"minute":      {"%s min", "%s mins"},
+		"year":        {"%s year", "%s years"},
+		"month":       {"%s month", "%s months"},
+		"week":        {"%s week", "%s weeks"},
+	},
+	Plural:           englishPlural,
+	Weekdays:         [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortWeekdays:    [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	ShortMonths:      [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	GroupSeparator:   ",",
+	DecimalSeparator: ".",
+}
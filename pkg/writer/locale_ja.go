@@ -0,0 +1,154 @@
+package writer
+
+var localeJA = Locale{
+	Messages: map[string]string{
+		"noData":      "データがありません",
+		"lastUpdated": "⏳ *最終更新: %s*",
+
+		"languages.title": "💬 言語とツール",
+
+		"waka.last_7_days":   "📅 過去7日間の統計",
+		"waka.last_30_days":  "📊 過去30日間の統計",
+		"waka.last_6_months": "📈 過去6か月の統計",
+		"waka.last_year":     "🗓️ 過去12か月の統計",
+		"waka.all_time":      "⏱️ 全期間の統計",
+		"waka.languages":     "💬 言語:",
+		"waka.editors":       "📝 エディタ:",
+		"waka.os":            "💻 OS:",
+		"waka.projects":      "📦 プロジェクト:",
+		"waka.categories":    "🏷️ カテゴリ:",
+		"waka.machines":      "🖥️ マシン:",
+		"waka.dependencies":  "🧩 依存関係:",
+		"waka.branches":      "🌿 ブランチ:",
+		"waka.others":        "その他",
+
+		"streak.title":        "📈 コーディングストリーク",
+		"streak.current":      "🔥 現在のストリーク:",
+		"streak.longest":      "🏆 最長ストリーク:",
+		"streak.dailyAverage": "📊 1日の平均:",
+		"streak.totalTime":    "💪 合計時間:",
+		"streak.consistency":  "🎯 継続率:",
+		"streak.activeDays":   "📅 活動日数:",
+		"streak.hoursMinutes": "%d時間%d分",
+		"streak.lastCommit":   "🕒 最後のコミット:",
+		"streak.today":        "今日",
+		"streak.ago":          "%s前",
+		"streak.longestGap":   "💤 最長の空白:",
+		"streak.top":          "ストリーク上位",
+		"streak.rank":         "%d. %s",
+
+		"ai.last_7_days":   "🤖 今週のAI",
+		"ai.last_30_days":  "🤖 今月のAI",
+		"ai.last_6_months": "🤖 6か月間のAI",
+		"ai.last_year":     "🤖 今年のAI",
+		"ai.all_time":      "🤖 AIの足跡",
+		"ai.generated":     "🤖 AIが生成:",
+		"ai.handwritten":   "👤 手書き:",
+		"ai.contribution":  "📊 AIの割合:",
+		"ai.tokens":        "🔤 入力/出力トークン:",
+		"ai.avgPrompt":     "💬 平均プロンプト:",
+
+		"times.title":          "🕒 私は%s",
+		"times.activeTitle":    "🕒 最も活発な時間帯: %s",
+		"times.morning":        "朝",
+		"times.daytime":        "昼",
+		"times.evening":        "夕方",
+		"times.night":          "夜",
+		"times.status.morning": "早起きの鳥 🐤",
+		"times.status.daytime": "午後の戦士 🥷🏻",
+		"times.status.evening": "黄昏の仕事人 🌆",
+		"times.status.night":   "夜ふかしのフクロウ 🦉",
+		"times.status.early":   "朝型 🐤",
+		"times.status.late":    "夜型 🦉",
+
+		"hours.title": "⏰ 最も活発な時刻: %s",
+
+		"days.title": "📅 最も生産的なのは%s",
+		"repo.title": "🔥 主に%sで開発",
+
+		"top.title":       "🏆 トップリポジトリ",
+		"top.description": "%s · %s",
+		"top.columns":     "コミット数・変更行数",
+		"top.private":     "🔒 非公開リポジトリ",
+
+		"calendar.title": "📆 過去1年間で%s",
+		"calendar.less":  "少",
+		"calendar.more":  "多",
+
+		"punch.title": "🕰️ 最多は%sの%s",
+
+		"profile.title":       "👤 プロフィール概要",
+		"profile.joined":      "🎂 GitHub 登録日:",
+		"profile.age":         "🎉 アカウント歴:",
+		"profile.yearsMonths": "%s%s",
+		"profile.repos":       "📦 リポジトリ:",
+		"profile.reposSplit":  "所有 %s / 貢献 %s",
+		"profile.firstCommit": "🌱 最初のコミット:",
+		"profile.commits":     "💾 総コミット数:",
+		"profile.activeDays":  "📅 活動日数:",
+		"profile.average":     "📊 1日あたりのコミット:",
+
+		"date":  "%[3]d年%[1]s%[2]d日",
+		"month": "%[2]d年%[1]s",
+
+		"periods.year":    "📈 年別コミット数",
+		"periods.quarter": "📈 四半期別コミット数",
+
+		"churn.title":   "📝 コードの増減",
+		"churn.added":   "➕ 追加行数:",
+		"churn.deleted": "➖ 削除行数:",
+		"churn.net":     "📊 純増減:",
+		"churn.average": "📏 平均コミット:",
+		"churn.netDiff": "%s行",
+		"churn.byYear":  "年別",
+		"churn.byMonth": "月別",
+
+		"sizes.title":  "📦 コミットサイズ",
+		"sizes.median": "📏 中央値:",
+		"sizes.p90":    "📐 90パーセンタイル:",
+		"sizes.bySize": "変更行数別",
+
+		"trend.months":   "📈 コミット推移（直近%dか月）",
+		"trend.weeks":    "📈 コミット推移（直近%d週）",
+		"trend.lowest":   "📉 最少:",
+		"trend.highest":  "📈 最多:",
+		"trend.average":  "📊 平均:",
+		"trend.when":     "%s（%s）",
+		"trend.perMonth": "月あたり%sコミット",
+		"trend.perWeek":  "週あたり%sコミット",
+
+		"daily.title":   "⌨️ 日別コーディング時間（直近%d日）",
+		"daily.best":    "🏆 最長の日:",
+		"daily.average": "📊 1日平均:",
+		"daily.total":   "💪 合計コーディング時間:",
+		"daily.when":    "%s（%s）",
+
+		"goals.title":   "🎯 コーディング目標",
+		"goals.streaks": "🔥 連続達成",
+		"goals.of":      "%s / %s",
+
+		"table.name":    "名前",
+		"table.value":   "値",
+		"table.bar":     "グラフ",
+		"table.percent": "割合",
+	},
+	Units: map[string][]string{
+		"commit":      {"%s コミット"},
+		"commitTitle": {"%s コミット"},
+		"repo":        {"%s リポジトリ"},
+		"day":         {"%s日"},
+		"line":        {"%s行"},
+		"char":        {"%s文字"},
+		"hour":        {"%s時間"},
+		"minute":      {"%s分"},
+		"year":        {"%s年"},
+		"month":       {"%sか月"},
+		"week":        {"%s週"},
+	},
+	Plural:           noPlural,
+	Weekdays:         [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	ShortWeekdays:    [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	ShortMonths:      [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	GroupSeparator:   ",",
+	DecimalSeparator: ".",
+}
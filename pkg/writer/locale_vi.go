@@ -0,0 +1,154 @@
+package writer
+
+var localeVI = Locale{
+	Messages: map[string]string{
+		"noData":      "Không có dữ liệu",
+		"lastUpdated": "⏳ *Cập nhật lần cuối lúc %s*",
+
+		"languages.title": "💬 Ngôn ngữ & Công cụ",
+
+		"waka.last_7_days":   "📅 Thống kê 7 ngày qua",
+		"waka.last_30_days":  "📊 Thống kê 30 ngày qua",
+		"waka.last_6_months": "📈 Thống kê 6 tháng qua",
+		"waka.last_year":     "🗓️ Thống kê 12 tháng qua",
+		"waka.all_time":      "⏱️ Thống kê toàn thời gian",
+		"waka.languages":     "💬 Ngôn ngữ:",
+		"waka.editors":       "📝 Trình soạn thảo:",
+		"waka.os":            "💻 Hệ điều hành:",
+		"waka.projects":      "📦 Dự án:",
+		"waka.categories":    "🏷️ Danh mục:",
+		"waka.machines":      "🖥️ Máy tính:",
+		"waka.dependencies":  "🧩 Thư viện phụ thuộc:",
+		"waka.branches":      "🌿 Nhánh:",
+		"waka.others":        "Khác",
+
+		"streak.title":        "📈 Chuỗi ngày lập trình",
+		"streak.current":      "🔥 Chuỗi hiện tại:",
+		"streak.longest":      "🏆 Chuỗi dài nhất:",
+		"streak.dailyAverage": "📊 Trung bình mỗi ngày:",
+		"streak.totalTime":    "💪 Tổng thời gian:",
+		"streak.consistency":  "🎯 Độ đều đặn:",
+		"streak.activeDays":   "📅 Số ngày hoạt động:",
+		"streak.hoursMinutes": "%d giờ %d phút",
+		"streak.lastCommit":   "🕒 Commit gần nhất:",
+		"streak.today":        "hôm nay",
+		"streak.ago":          "%s trước",
+		"streak.longestGap":   "💤 Nghỉ lâu nhất:",
+		"streak.top":          "Chuỗi dài nhất",
+		"streak.rank":         "%d. %s",
+
+		"ai.last_7_days":   "🤖 Một tuần cùng AI",
+		"ai.last_30_days":  "🤖 Một tháng cùng AI",
+		"ai.last_6_months": "🤖 6 tháng cùng AI",
+		"ai.last_year":     "🤖 Một năm cùng AI",
+		"ai.all_time":      "🤖 Dấu ấn AI của tôi",
+		"ai.generated":     "🤖 Do AI tạo:",
+		"ai.handwritten":   "👤 Tự viết:",
+		"ai.contribution":  "📊 Tỷ lệ AI:",
+		"ai.tokens":        "🔤 Token vào / ra:",
+		"ai.avgPrompt":     "💬 Prompt trung bình:",
+
+		"times.title":          "🕒 Tôi là %s",
+		"times.activeTitle":    "🕒 Hoạt động nhiều nhất: %s",
+		"times.morning":        "Buổi sáng",
+		"times.daytime":        "Buổi chiều",
+		"times.evening":        "Buổi tối",
+		"times.night":          "Ban đêm",
+		"times.status.morning": "Chú chim dậy sớm 🐤",
+		"times.status.daytime": "Chiến binh buổi chiều 🥷🏻",
+		"times.status.evening": "Người chinh phục hoàng hôn 🌆",
+		"times.status.night":   "Cú đêm 🦉",
+		"times.status.early":   "Người dậy sớm 🐤",
+		"times.status.late":    "Cú đêm 🦉",
+
+		"hours.title": "⏰ Giờ bận rộn nhất: %s",
+
+		"days.title": "📅 Tôi làm việc hiệu quả nhất vào %s",
+		"repo.title": "🔥 Tôi chủ yếu viết %s",
+
+		"top.title":       "🏆 Repository hàng đầu",
+		"top.description": "%s · %s",
+		"top.columns":     "Commit · Số dòng thay đổi",
+		"top.private":     "🔒 Repo riêng tư",
+
+		"calendar.title": "📆 %s trong năm qua",
+		"calendar.less":  "Ít",
+		"calendar.more":  "Nhiều",
+
+		"punch.title": "🕰️ Nhiều commit nhất vào %s lúc %s",
+
+		"profile.title":       "👤 Tổng quan hồ sơ",
+		"profile.joined":      "🎂 Tham gia GitHub:",
+		"profile.age":         "🎉 Tuổi tài khoản:",
+		"profile.yearsMonths": "%s %s",
+		"profile.repos":       "📦 Repository:",
+		"profile.reposSplit":  "%s sở hữu / %s đóng góp",
+		"profile.firstCommit": "🌱 Commit đầu tiên:",
+		"profile.commits":     "💾 Tổng số commit:",
+		"profile.activeDays":  "📅 Số ngày hoạt động:",
+		"profile.average":     "📊 Commit mỗi ngày:",
+
+		"date":  "%[2]d %[1]s, %[3]d",
+		"month": "%[1]s %[2]d",
+
+		"periods.year":    "📈 Commit theo năm",
+		"periods.quarter": "📈 Commit theo quý",
+
+		"churn.title":   "📝 Biến động mã nguồn",
+		"churn.added":   "➕ Dòng thêm:",
+		"churn.deleted": "➖ Dòng xóa:",
+		"churn.net":     "📊 Thay đổi ròng:",
+		"churn.average": "📏 Commit trung bình:",
+		"churn.netDiff": "%s dòng",
+		"churn.byYear":  "Theo năm",
+		"churn.byMonth": "Theo tháng",
+
+		"sizes.title":  "📦 Kích thước commit",
+		"sizes.median": "📏 Commit trung vị:",
+		"sizes.p90":    "📐 Phân vị 90:",
+		"sizes.bySize": "Theo số dòng thay đổi",
+
+		"trend.months":   "📈 Xu hướng commit, %d tháng gần nhất",
+		"trend.weeks":    "📈 Xu hướng commit, %d tuần gần nhất",
+		"trend.lowest":   "📉 Thấp nhất:",
+		"trend.highest":  "📈 Cao nhất:",
+		"trend.average":  "📊 Trung bình:",
+		"trend.when":     "%s (%s)",
+		"trend.perMonth": "%s commit mỗi tháng",
+		"trend.perWeek":  "%s commit mỗi tuần",
+
+		"daily.title":   "⌨️ Thời gian code mỗi ngày, %d ngày gần nhất",
+		"daily.best":    "🏆 Ngày cao nhất:",
+		"daily.average": "📊 Trung bình mỗi ngày:",
+		"daily.total":   "💪 Tổng thời gian code:",
+		"daily.when":    "%s (%s)",
+
+		"goals.title":   "🎯 Mục tiêu code",
+		"goals.streaks": "🔥 Chuỗi hoàn thành",
+		"goals.of":      "%s / %s",
+
+		"table.name":    "Tên",
+		"table.value":   "Giá trị",
+		"table.bar":     "Tiến độ",
+		"table.percent": "Tỷ lệ",
+	},
+	Units: map[string][]string{
+		"commit":      {"%s commit"},
+		"commitTitle": {"%s commit"},
+		"repo":        {"%s repo"},
+		"day":         {"%s ngày"},
+		"line":        {"%s dòng"},
+		"char":        {"%s ký tự"},
+		"hour":        {"%s giờ"},
+		"minute":      {"%s phút"},
+		"year":        {"%s năm"},
+		"month":       {"%s tháng"},
+		"week":        {"%s tuần"},
+	},
+	Plural:           noPlural,
+	Weekdays:         [7]string{"Chủ nhật", "Thứ hai", "Thứ ba", "Thứ tư", "Thứ năm", "Thứ sáu", "Thứ bảy"},
+	ShortWeekdays:    [7]string{"CN", "T2", "T3", "T4", "T5", "T6", "T7"},
+	ShortMonths:      [12]string{"Th1", "Th2", "Th3", "Th4", "Th5", "Th6", "Th7", "Th8", "Th9", "Th10", "Th11", "Th12"},
+	GroupSeparator:   ".",
+	DecimalSeparator: ",",
+}
@@ -0,0 +1,73 @@
+package writer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MakeMermaidPie renders a Card as a Mermaid pie chart of the row counts.
+// Rows with a zero count are left out because Mermaid cannot draw empty slices.
+func MakeMermaidPie(c *Card) string {
+	if c == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	writeMermaidHeader(&b, c.Title)
+	b.WriteString("pie showData\n")
+	for _, g := range c.Groups {
+		if g.Stats {
+			continue // summary rows, not chart data
+		}
+
+		for _, r := range g.Rows {
+			if r.Count <= 0 {
+				continue
+			}
+
+			fmt.Fprintf(&b, "    %s : %d\n", mermaidString(r.Name), r.Count)
+		}
+	}
+	b.WriteString("```\n\n")
+
+	return b.String()
+}
+
+// MakeMermaidXYChart renders a Card as a Mermaid xychart-beta bar chart of the row counts
+func MakeMermaidXYChart(c *Card) string {
+	if c == nil {
+		return ""
+	}
+
+	var labels, values []string
+	for _, g := range c.Groups {
+		if g.Stats {
+			continue // summary rows, not chart data
+		}
+
+		for _, r := range g.Rows {
+			labels = append(labels, mermaidString(r.Name))
+			values = append(values, fmt.Sprint(r.Count))
+		}
+	}
+
+	var b strings.Builder
+	writeMermaidHeader(&b, c.Title)
+	b.WriteString("xychart-beta\n")
+	fmt.Fprintf(&b, "    x-axis [%s]\n", strings.Join(labels, ", "))
+	fmt.Fprintf(&b, "    bar [%s]\n", strings.Join(values, ", "))
+	b.WriteString("```\n\n")
+
+	return b.String()
+}
+
+func writeMermaidHeader(b *strings.Builder, title string) {
+	b.WriteString("**")
+	b.WriteString(title)
+	b.WriteString("**\n\n```mermaid\n")
+}
+
+// mermaidString quotes s for a Mermaid label; Mermaid has no escape for double quotes
+func mermaidString(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "'") + `"`
+}
@@ -0,0 +1,143 @@
+package writer
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MakeCommitsPerYearList returns the commits per year with the change over the previous year
+func MakeCommitsPerYearList(yearly map[int]int, limit int, version string) string {
+	return makeBlock(CommitsPerYearCard(yearly, limit), version)
+}
+
+// CommitsPerYearCard returns the commits per year as a Card, oldest first.
+// Years without commits between the first and the last year are kept as zero rows.
+// A positive limit keeps only the last limit years.
+func CommitsPerYearCard(yearly map[int]int, limit int) *Card {
+	if len(yearly) == 0 {
+		return nil
+	}
+
+	first, last := 0, 0
+	for y := range yearly {
+		if first == 0 || y < first {
+			first = y
+		}
+		last = max(last, y)
+	}
+
+	var keys []string
+	for y := first; y <= last; y++ {
+		keys = append(keys, strconv.Itoa(y))
+	}
+
+	counts := make(map[string]int, len(yearly))
+	for y, n := range yearly {
+		counts[strconv.Itoa(y)] = n
+	}
+
+	return &Card{
+		Title:  tr("periods.year"),
+		Groups: []Group{{Rows: periodRows(keys, counts, 1, limit)}},
+	}
+}
+
+// MakeCommitsPerQuarterList returns the commits per quarter with the change over the same quarter a year earlier
+func MakeCommitsPerQuarterList(quarterly map[string]int, limit int, version string) string {
+	return makeBlock(CommitsPerQuarterCard(quarterly, limit), version)
+}
+
+// CommitsPerQuarterCard returns the commits per quarter (keys like "2026-Q1") as a Card, oldest first.
+// Quarters without commits between the first and the last quarter are kept as zero rows.
+// A positive limit keeps only the last limit quarters.
+func CommitsPerQuarterCard(quarterly map[string]int, limit int) *Card {
+	if len(quarterly) == 0 {
+		return nil
+	}
+
+	var present []string
+	for k := range quarterly {
+		present = append(present, k)
+	}
+	sort.Strings(present)
+
+	fy, fq := parseQuarter(present[0])
+	ly, lq := parseQuarter(present[len(present)-1])
+
+	var keys []string
+	for y, q := fy, fq; y < ly || (y == ly && q <= lq); {
+		keys = append(keys, fmt.Sprintf("%d-Q%d", y, q))
+		if q++; q > 4 {
+			y, q = y+1, 1
+		}
+	}
+
+	return &Card{
+		Title:  tr("periods.quarter"),
+		Groups: []Group{{Rows: periodRows(keys, quarterly, 4, limit)}},
+	}
+}
+
+// periodRows builds one row per period key in chronological order. The change is
+// measured against the period lag keys earlier, i.e. the same period a year before.
+func periodRows(keys []string, counts map[string]int, lag, limit int) []Data {
+	from := 0
+	if limit > 0 && len(keys) > limit {
+		from = len(keys) - limit
+	}
+
+	total := 0
+	for _, k := range keys[from:] {
+		total += counts[k]
+	}
+
+	var data []Data
+	for i := from; i < len(keys); i++ {
+		n := counts[keys[i]]
+		name := keys[i]
+		if i >= lag {
+			name += formatChange(counts[keys[i-lag]], n)
+		}
+
+		var p float64
+		if total > 0 {
+			p = float64(n) / float64(total) * 100
+		}
+
+		data = append(data, Data{
+			Name:        name,
+			Description: formatUnit(int64(n), "commit"),
+			Percent:     p,
+			Count:       n,
+		})
+	}
+
+	return data
+}
+
+// formatChange returns the change from prev to cur as " (+12.5%)", or "" when there is nothing to compare against
+func formatChange(prev, cur int) string {
+	if prev == 0 {
+		return ""
+	}
+
+	change := float64(cur-prev) / float64(prev) * 100
+	sign := "+"
+	if change < 0 {
+		sign = "-"
+		change = -change
+	}
+
+	return " (" + sign + formatDecimal("%.1f%%", change) + ")"
+}
+
+// parseQuarter splits a "2026-Q1" key into its year and quarter
+func parseQuarter(key string) (year, quarter int) {
+	y, q, _ := strings.Cut(key, "-Q")
+	year, _ = strconv.Atoi(y)
+	quarter, _ = strconv.Atoi(q)
+
+	return year, quarter
+}
@@ -0,0 +1,76 @@
+package writer
+
+import (
+	"time"
+)
+
+// MakeProfileSummaryList returns the account age, repository and commit totals of the profile
+func MakeProfileSummaryList(createdAt, firstCommit time.Time, ownedRepos, contributedRepos, commits, activeDays int, now time.Time) string {
+	return makeBlock(ProfileSummaryCard(createdAt, firstCommit, ownedRepos, contributedRepos, commits, activeDays, now), "")
+}
+
+// ProfileSummaryCard returns the profile headline as a Card of stat rows. The account age
+// is left out when createdAt is zero, the commit rows when there are no commits.
+func ProfileSummaryCard(createdAt, firstCommit time.Time, ownedRepos, contributedRepos, commits, activeDays int, now time.Time) *Card {
+	if createdAt.IsZero() && commits == 0 && ownedRepos+contributedRepos == 0 {
+		return nil
+	}
+
+	var rows []Data
+	if !createdAt.IsZero() {
+		rows = append(rows,
+			Data{Name: tr("profile.joined"), Description: formatDate(createdAt.In(now.Location()))},
+			Data{Name: tr("profile.age"), Description: formatAge(createdAt.In(now.Location()), now)},
+		)
+	}
+
+	rows = append(rows, Data{Name: tr("profile.repos"), Description: tr("profile.reposSplit", formatNumber(ownedRepos), formatNumber(contributedRepos))})
+
+	if commits > 0 {
+		var average float64
+		if activeDays > 0 {
+			average = float64(commits) / float64(activeDays)
+		}
+
+		rows = append(rows,
+			Data{Name: tr("profile.firstCommit"), Description: formatDate(firstCommit)},
+			Data{Name: tr("profile.commits"), Description: formatUnit(int64(commits), "commit")},
+			Data{Name: tr("profile.activeDays"), Description: formatUnit(int64(activeDays), "day")},
+			Data{Name: tr("profile.average"), Description: formatDecimal("%.1f", average)},
+		)
+	}
+
+	return &Card{
+		Title:  tr("profile.title"),
+		Groups: []Group{{Rows: rows, Stats: true}},
+	}
+}
+
+// formatDate returns t as a localized date such as "Mar 14, 2019"
+func formatDate(t time.Time) string {
+	return tr("date", currentLocale().ShortMonths[t.Month()-1], t.Day(), t.Year())
+}
+
+// formatAge returns the whole years and months from since to now, or the days when
+// it is less than a month
+func formatAge(since, now time.Time) string {
+	months := (now.Year()-since.Year())*12 + int(now.Month()) - int(since.Month())
+	if now.Day() < since.Day() {
+		months--
+	}
+
+	if months < 1 {
+		days := int(now.Sub(since).Hours() / 24)
+		return formatUnit(int64(max(days, 0)), "day")
+	}
+
+	years, months := months/12, months%12
+	switch {
+	case years == 0:
+		return formatUnit(int64(months), "month")
+	case months == 0:
+		return formatUnit(int64(years), "year")
+	}
+
+	return tr("profile.yearsMonths", formatUnit(int64(years), "year"), formatUnit(int64(months), "month"))
+}
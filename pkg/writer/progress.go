@@ -0,0 +1,173 @@
+package writer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Built-in progress bar styles. "1" and "2" are the original PROGRESS_BAR_VERSION values.
+const (
+	ProgressBarBlocks  = "1"
+	ProgressBarEmoji   = "2"
+	ProgressBarEighths = "eighths"
+)
+
+// maxProgressBarWidth keeps custom bars inside a readable line
+const maxProgressBarWidth = 100
+
+// ProgressBarStyle describes how a progress bar is drawn. Without Partials the
+// filled length is rounded to whole cells; with Partials any remainder is drawn
+// with the partial glyph covering it, from the least to the most filled one.
+type ProgressBarStyle struct {
+	Filled   string
+	Partials []string
+	Empty    string
+	Width    int
+}
+
+// progressBarStylesMu guards progressBarStyles, which RegisterProgressBarStyle extends at startup
+var progressBarStylesMu sync.RWMutex
+
+var progressBarStyles = map[string]ProgressBarStyle{
+	ProgressBarBlocks: {Filled: "█", Empty: "░", Width: graphLength},
+	ProgressBarEmoji:  {Filled: "🟩", Partials: []string{"🟨"}, Empty: "⬜", Width: graphLength},
+	ProgressBarEighths: {
+		Filled:   "█",
+		Partials: []string{"▏", "▎", "▍", "▌", "▋", "▊", "▉"},
+		Empty:    "░",
+		Width:    graphLength,
+	},
+}
+
+// IsBuiltinProgressBarStyle reports whether name is one of the built-in presets
+func IsBuiltinProgressBarStyle(name string) bool {
+	switch name {
+	case ProgressBarBlocks, ProgressBarEmoji, ProgressBarEighths:
+		return true
+	}
+
+	return false
+}
+
+// RegisterProgressBarStyle adds a named style, so it can be selected by name
+func RegisterProgressBarStyle(name string, s ProgressBarStyle) error {
+	if name == "" {
+		return fmt.Errorf("progress bar style name is empty")
+	}
+
+	if IsBuiltinProgressBarStyle(name) {
+		return fmt.Errorf("progress bar style %q is built in and cannot be redefined", name)
+	}
+
+	if err := s.validate(); err != nil {
+		return fmt.Errorf("progress bar style %q: %w", name, err)
+	}
+
+	progressBarStylesMu.Lock()
+	progressBarStyles[name] = s
+	progressBarStylesMu.Unlock()
+
+	return nil
+}
+
+// HasProgressBarStyle reports whether a style is registered under name
+func HasProgressBarStyle(name string) bool {
+	_, ok := progressBarStyle(name)
+	return ok
+}
+
+// progressBarStyle returns the style registered under name
+func progressBarStyle(name string) (ProgressBarStyle, bool) {
+	progressBarStylesMu.RLock()
+	defer progressBarStylesMu.RUnlock()
+
+	s, ok := progressBarStyles[name]
+	return s, ok
+}
+
+// ParseProgressBarStyle parses a "filled|empty|width|partials" definition, e.g. "█|░|30|▏▎▍▌▋▊▉".
+// Width and partials are optional; partials are one glyph per character, or space-separated.
+func ParseProgressBarStyle(def string) (ProgressBarStyle, error) {
+	parts := strings.Split(def, "|")
+	if len(parts) < 2 || len(parts) > 4 {
+		return ProgressBarStyle{}, fmt.Errorf("expected filled|empty|width|partials")
+	}
+
+	s := ProgressBarStyle{
+		Filled: strings.TrimSpace(parts[0]),
+		Empty:  strings.TrimSpace(parts[1]),
+		Width:  graphLength,
+	}
+
+	if len(parts) > 2 && strings.TrimSpace(parts[2]) != "" {
+		w, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+		if err != nil {
+			return ProgressBarStyle{}, fmt.Errorf("width must be a number")
+		}
+		s.Width = w
+	}
+
+	if len(parts) > 3 {
+		p := strings.TrimSpace(parts[3])
+		if strings.Contains(p, " ") {
+			s.Partials = strings.Fields(p)
+		} else {
+			for _, r := range p {
+				s.Partials = append(s.Partials, string(r))
+			}
+		}
+	}
+
+	return s, s.validate()
+}
+
+func (s ProgressBarStyle) validate() error {
+	if s.Filled == "" || s.Empty == "" {
+		return fmt.Errorf("filled and empty glyphs are required")
+	}
+
+	if s.Width < 1 || s.Width > maxProgressBarWidth {
+		return fmt.Errorf("width must be between 1 and %d", maxProgressBarWidth)
+	}
+
+	if !utf8.ValidString(s.Filled + s.Empty + strings.Join(s.Partials, "")) {
+		return fmt.Errorf("glyphs must be valid UTF-8")
+	}
+
+	return nil
+}
+
+// makeProgressBar draws p percent in the named style, falling back to the block style
+func makeProgressBar(p float64, name string) string {
+	s, ok := progressBarStyle(name)
+	if !ok {
+		s, _ = progressBarStyle(ProgressBarBlocks)
+	}
+
+	return s.draw(p)
+}
+
+func (s ProgressBarStyle) draw(p float64) string {
+	cells := math.Max(0, math.Min(p, 100)) / (100 / float64(s.Width))
+
+	filled := int(math.Round(cells))
+	partial := ""
+	if len(s.Partials) > 0 {
+		filled = int(math.Floor(cells))
+		if rest := cells - float64(filled); rest > 0 {
+			i := int(math.Ceil(rest*float64(len(s.Partials)))) - 1
+			partial = s.Partials[min(i, len(s.Partials)-1)]
+		}
+	}
+
+	empty := s.Width - filled
+	if partial != "" {
+		empty--
+	}
+
+	return strings.Repeat(s.Filled, filled) + partial + strings.Repeat(s.Empty, max(0, empty))
+}
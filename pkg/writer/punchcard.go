@@ -0,0 +1,57 @@
+package writer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thanhhaudev/github-stats/pkg/github"
+)
+
+// punchCardLabelEvery is the number of hours between the column labels of the punch card
+const punchCardLabelEvery = 3
+
+// MakeCommitPunchCard returns a weekday by hour heatmap of the commits
+func MakeCommitPunchCard(commits []github.Commit, loc *time.Location) string {
+	return makeBlock(PunchCardCard(commits, loc), "")
+}
+
+// PunchCardCard returns the commits per weekday and hour in loc as a 7×24 heatmap Card.
+// Rows are weekdays starting on Sunday, columns are hours starting at midnight.
+func PunchCardCard(commits []github.Commit, loc *time.Location) *Card {
+	if len(commits) == 0 {
+		return nil
+	}
+
+	g := &Grid{
+		Rows:    make([]string, 7),
+		Columns: make([]string, 24),
+		Cells:   make([][]int, 7),
+		Circles: true,
+	}
+	for d := range g.Cells {
+		g.Rows[d] = currentLocale().ShortWeekdays[d]
+		g.Cells[d] = make([]int, 24)
+	}
+	for h := 0; h < 24; h += punchCardLabelEvery {
+		g.Columns[h] = fmt.Sprintf("%02d", h)
+	}
+
+	for _, c := range commits {
+		t := c.CommittedDate.In(loc)
+		g.Cells[t.Weekday()][t.Hour()]++
+	}
+
+	var topDay, topHour int
+	for d, hours := range g.Cells {
+		for h, n := range hours {
+			if n > g.Max {
+				g.Max, topDay, topHour = n, d, h
+			}
+		}
+	}
+
+	return &Card{
+		Title: tr("punch.title", weekdayName(time.Weekday(topDay)), formatHour(topHour)),
+		Grid:  g,
+	}
+}
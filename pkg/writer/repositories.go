@@ -0,0 +1,91 @@
+package writer
+
+import (
+	"sort"
+
+	"github.com/thanhhaudev/github-stats/pkg/github"
+)
+
+// MakeTopRepositoriesList returns the repositories with the most commits
+func MakeTopRepositoriesList(commits []github.Commit, repos []github.Repository, limit int, hidePrivate bool, version string) string {
+	return makeBlock(TopRepositoriesCard(commits, repos, limit, hidePrivate), version)
+}
+
+// TopRepositoriesCard returns the limit repositories with the most commits as a Card, with
+// their commit count and lines changed, additions plus deletions. Percent is the share of all attributed commits.
+// With hidePrivate the private repositories are counted together as a single row.
+func TopRepositoriesCard(commits []github.Commit, repos []github.Repository, limit int, hidePrivate bool) *Card {
+	byURL := make(map[string]github.Repository, len(repos))
+	for _, r := range repos {
+		byURL[r.Url] = r
+	}
+
+	type repoTotals struct {
+		name    string
+		commits int
+		lines   int64
+	}
+
+	var (
+		total  int
+		totals = make(map[string]*repoTotals)
+	)
+	for _, c := range commits {
+		r, ok := byURL[c.Repository]
+		if !ok {
+			continue // not attributed to a known repository
+		}
+
+		key, name := r.Url, r.Name
+		if hidePrivate && r.IsPrivate {
+			key, name = "", tr("top.private")
+		}
+
+		t, ok := totals[key]
+		if !ok {
+			t = &repoTotals{name: name}
+			totals[key] = t
+		}
+		t.commits++
+		t.lines += int64(c.Additions + c.Deletions)
+		total++
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	sorted := make([]*repoTotals, 0, len(totals))
+	for _, t := range totals {
+		sorted = append(sorted, t)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].commits != sorted[j].commits {
+			return sorted[i].commits > sorted[j].commits
+		}
+		if sorted[i].lines != sorted[j].lines {
+			return sorted[i].lines > sorted[j].lines
+		}
+
+		return sorted[i].name < sorted[j].name
+	})
+
+	if limit > 0 && len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+
+	data := make([]Data, 0, len(sorted))
+	for _, t := range sorted {
+		data = append(data, Data{
+			Name:        t.name,
+			Description: tr("top.description", formatNumber(t.commits), humanizeCount(t.lines)),
+			Percent:     float64(t.commits) / float64(total) * 100,
+			Count:       t.commits,
+		})
+	}
+
+	return &Card{
+		Title:  tr("top.title"),
+		Groups: []Group{{Label: tr("top.columns"), Rows: data}},
+	}
+}
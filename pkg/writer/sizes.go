@@ -0,0 +1,89 @@
+package writer
+
+import (
+	"math"
+	"sort"
+
+	"github.com/thanhhaudev/github-stats/pkg/github"
+)
+
+// commitSizeBuckets are the COMMIT_SIZES buckets with their exclusive upper bound in
+// changed lines; L includes 1,000 lines, so XL holds the commits over 1,000
+var commitSizeBuckets = []struct {
+	name  string
+	limit int
+}{
+	{name: "XS", limit: 10},
+	{name: "S", limit: 50},
+	{name: "M", limit: 250},
+	{name: "L", limit: 1001},
+	{name: "XL", limit: math.MaxInt},
+}
+
+// MakeCommitSizesList returns the distribution of commit sizes with the median and p90
+func MakeCommitSizesList(commits []github.Commit, version string) string {
+	return makeBlock(CommitSizesCard(commits), version)
+}
+
+// CommitSizesCard returns the commits bucketed by changed lines (added plus deleted) as
+// a Card, with the median and 90th percentile commit size as stat rows
+func CommitSizesCard(commits []github.Commit) *Card {
+	if len(commits) == 0 {
+		return nil
+	}
+
+	sizes := make([]int, len(commits))
+	counts := make([]int, len(commitSizeBuckets))
+	for i, c := range commits {
+		sizes[i] = c.Additions + c.Deletions
+		for b, bucket := range commitSizeBuckets {
+			if sizes[i] < bucket.limit {
+				counts[b]++
+				break
+			}
+		}
+	}
+	sort.Ints(sizes)
+
+	rows := make([]Data, 0, len(commitSizeBuckets))
+	for b, bucket := range commitSizeBuckets {
+		rows = append(rows, Data{
+			Name:        bucket.name + " " + commitSizeRange(b),
+			Description: formatUnit(int64(counts[b]), "commit"),
+			Percent:     float64(counts[b]) / float64(len(commits)) * 100,
+			Count:       counts[b],
+		})
+	}
+
+	return &Card{
+		Title: tr("sizes.title"),
+		Groups: []Group{
+			{
+				Rows: []Data{
+					{Name: tr("sizes.median"), Description: formatUnit(int64(percentile(sizes, 50)), "line")},
+					{Name: tr("sizes.p90"), Description: formatUnit(int64(percentile(sizes, 90)), "line")},
+				},
+				Stats: true,
+			},
+			{Label: tr("sizes.bySize"), Rows: rows},
+		},
+	}
+}
+
+// commitSizeRange returns the lines covered by bucket b, such as "(10–49)"
+func commitSizeRange(b int) string {
+	switch b {
+	case 0:
+		return "(<" + formatNumber(commitSizeBuckets[0].limit) + ")"
+	case len(commitSizeBuckets) - 1:
+		return "(>" + formatNumber(commitSizeBuckets[b-1].limit-1) + ")"
+	}
+
+	return "(" + formatNumber(commitSizeBuckets[b-1].limit) + "–" + formatNumber(commitSizeBuckets[b].limit-1) + ")"
+}
+
+// percentile returns the nearest-rank p-th percentile of the sorted values
+func percentile(sorted []int, p float64) int {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
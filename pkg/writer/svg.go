@@ -0,0 +1,259 @@
+package writer
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+const (
+	svgWidth          = 495
+	svgPadding        = 25
+	svgTitleHeight    = 50
+	svgRowHeight      = 25
+	svgGroupGap       = 10
+	svgBottomMargin   = 15
+	svgLabelWidth     = 22
+	svgValueX         = 200
+	svgStatValueX     = 230
+	svgBarX           = 330
+	svgBarWidth       = 100
+	svgBarHeight      = 8
+	svgCellSize       = 10
+	svgCellGap        = 2
+	svgCircleCellSize = 18
+	svgGridLabelW     = 30
+	svgGapAfterGrid   = 8
+)
+
+// Valid SVG themes
+const (
+	SVGThemeAuto  = "auto"
+	SVGThemeLight = "light"
+	SVGThemeDark  = "dark"
+)
+
+type svgTheme struct {
+	Background string
+	Border     string
+	Title      string
+	Text       string
+	Muted      string
+	Track      string
+	Bar        string
+	Levels     [heatLevels + 1]string // heatmap colors from no activity to the busiest bucket
+}
+
+var svgThemes = map[string]svgTheme{
+	SVGThemeLight: {
+		Background: "#ffffff",
+		Border:     "#d0d7de",
+		Title:      "#1f2328",
+		Text:       "#1f2328",
+		Muted:      "#59636e",
+		Track:      "#eff2f5",
+		Bar:        "#2da44e",
+		Levels:     [heatLevels + 1]string{"#ebedf0", "#9be9a8", "#40c463", "#30a14e", "#216e39"},
+	},
+	SVGThemeDark: {
+		Background: "#0d1117",
+		Border:     "#30363d",
+		Title:      "#f0f6fc",
+		Text:       "#f0f6fc",
+		Muted:      "#9198a1",
+		Track:      "#21262d",
+		Bar:        "#3fb950",
+		Levels:     [heatLevels + 1]string{"#161b22", "#0e4429", "#006d32", "#26a641", "#39d353"},
+	},
+}
+
+// MakeSVGCard renders a Card as a standalone SVG image.
+// The "auto" theme follows the viewer's light/dark preference through a CSS media query.
+func MakeSVGCard(c *Card, theme string) string {
+	if c == nil {
+		return ""
+	}
+
+	if c.Grid != nil {
+		return makeSVGGrid(c.Title, c.Grid, theme)
+	}
+
+	height := svgTitleHeight + svgBottomMargin
+	for i, g := range c.Groups {
+		if i > 0 {
+			height += svgGroupGap
+		}
+
+		if g.Label != "" {
+			height += svgRowHeight
+		}
+
+		height += svgRowHeight * max(1, len(g.Rows))
+	}
+
+	var b strings.Builder
+	writeSVGHeader(&b, c.Title, svgWidth, height, theme)
+
+	y := svgTitleHeight
+	for i, g := range c.Groups {
+		if i > 0 {
+			y += svgGroupGap
+		}
+
+		if g.Label != "" {
+			y += svgRowHeight
+			fmt.Fprintf(&b, "<text class=\"group\" x=\"%d\" y=\"%d\">%s</text>\n", svgPadding, y, html.EscapeString(g.Label))
+		}
+
+		if len(g.Rows) == 0 {
+			y += svgRowHeight
+			fmt.Fprintf(&b, "<text class=\"value\" x=\"%d\" y=\"%d\">%s</text>\n", svgPadding, y, html.EscapeString(tr("noData")))
+			continue
+		}
+
+		for _, r := range g.Rows {
+			y += svgRowHeight
+			if g.Stats {
+				fmt.Fprintf(&b, "<text class=\"label\" x=\"%d\" y=\"%d\">%s</text>\n", svgPadding, y, html.EscapeString(r.Name))
+				fmt.Fprintf(&b, "<text class=\"value\" x=\"%d\" y=\"%d\">%s</text>\n", svgStatValueX, y, html.EscapeString(r.Description))
+				continue
+			}
+
+			writeSVGBarRow(&b, r, y)
+		}
+	}
+
+	b.WriteString("</svg>\n")
+
+	return b.String()
+}
+
+// MakeSVGEmbed returns the Markdown that embeds an SVG card stored at src
+func MakeSVGEmbed(src, title string) string {
+	return fmt.Sprintf("<img src=\"%s\" alt=\"%s\" />\n\n", html.EscapeString(src), html.EscapeString(title))
+}
+
+// SVGFileName returns the file name used for a metric's SVG card, e.g. github-stats-coding-streak.svg
+func SVGFileName(metric string) string {
+	return "github-stats-" + strings.ToLower(strings.ReplaceAll(metric, "_", "-")) + ".svg"
+}
+
+func writeSVGHeader(b *strings.Builder, title string, width, height int, theme string) {
+	fmt.Fprintf(b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\" role=\"img\" aria-labelledby=\"title\">\n", width, height, width, height)
+	fmt.Fprintf(b, "<title id=\"title\">%s</title>\n", html.EscapeString(title))
+	b.WriteString("<style>\n")
+	b.WriteString(".title{font:600 16px 'Segoe UI',Ubuntu,Sans-Serif}\n")
+	b.WriteString(".group{font:600 13px 'Segoe UI',Ubuntu,Sans-Serif}\n")
+	b.WriteString(".label{font:400 13px 'Segoe UI',Ubuntu,Sans-Serif}\n")
+	b.WriteString(".value{font:400 12px 'Segoe UI',Ubuntu,Sans-Serif}\n")
+
+	switch theme {
+	case SVGThemeLight, SVGThemeDark:
+		writeSVGThemeRules(b, svgThemes[theme])
+	default:
+		writeSVGThemeRules(b, svgThemes[SVGThemeLight])
+		b.WriteString("@media (prefers-color-scheme: dark){\n")
+		writeSVGThemeRules(b, svgThemes[SVGThemeDark])
+		b.WriteString("}\n")
+	}
+
+	b.WriteString("</style>\n")
+	fmt.Fprintf(b, "<rect class=\"bg\" x=\"0.5\" y=\"0.5\" rx=\"6\" width=\"%d\" height=\"%d\"/>\n", width-1, height-1)
+	fmt.Fprintf(b, "<text class=\"title\" x=\"%d\" y=\"%d\">%s</text>\n", svgPadding, svgPadding+10, html.EscapeString(title))
+}
+
+func writeSVGThemeRules(b *strings.Builder, t svgTheme) {
+	fmt.Fprintf(b, ".bg{fill:%s;stroke:%s}\n", t.Background, t.Border)
+	fmt.Fprintf(b, ".title,.group{fill:%s}\n", t.Title)
+	fmt.Fprintf(b, ".label{fill:%s}\n", t.Text)
+	fmt.Fprintf(b, ".value{fill:%s}\n", t.Muted)
+	fmt.Fprintf(b, ".track{fill:%s}\n", t.Track)
+	fmt.Fprintf(b, ".bar{fill:%s}\n", t.Bar)
+	for i, c := range t.Levels {
+		fmt.Fprintf(b, ".l%d{fill:%s}\n", i, c)
+	}
+}
+
+func writeSVGBarRow(b *strings.Builder, r Data, y int) {
+	fmt.Fprintf(b, "<text class=\"label\" x=\"%d\" y=\"%d\">%s</text>\n", svgPadding, y, html.EscapeString(truncateString(r.Name, svgLabelWidth)))
+	fmt.Fprintf(b, "<text class=\"value\" x=\"%d\" y=\"%d\">%s</text>\n", svgValueX, y, html.EscapeString(truncateString(r.Description, descriptionColumnWidth)))
+
+	barY := y - svgBarHeight - 1
+	fmt.Fprintf(b, "<rect class=\"track\" x=\"%d\" y=\"%d\" rx=\"4\" width=\"%d\" height=\"%d\"/>\n", svgBarX, barY, svgBarWidth, svgBarHeight)
+
+	filled := int(r.barPercent() / 100 * svgBarWidth)
+	if filled > 0 {
+		style := ""
+		if r.Color != "" {
+			style = fmt.Sprintf(" style=\"fill:#%s\"", html.EscapeString(r.Color))
+		}
+
+		fmt.Fprintf(b, "<rect class=\"bar\"%s x=\"%d\" y=\"%d\" rx=\"4\" width=\"%d\" height=\"%d\"/>\n", style, svgBarX, barY, min(filled, svgBarWidth), svgBarHeight)
+	}
+
+	fmt.Fprintf(b, "<text class=\"value\" x=\"%d\" y=\"%d\" text-anchor=\"end\">%s</text>\n", svgWidth-svgPadding, y, formatPercent(r.Percent))
+}
+
+// makeSVGGrid renders a heatmap Grid as rows of colored squares, or circles for
+// Grid.Circles, with a legend
+func makeSVGGrid(title string, g *Grid, theme string) string {
+	cell := svgCellSize
+	if g.Circles {
+		cell = svgCircleCellSize
+	}
+
+	step := cell + svgCellGap
+	top := svgTitleHeight + svgRowHeight/2
+	left := svgPadding + svgGridLabelW
+	width := max(svgWidth, left+len(g.Columns)*step+svgPadding)
+	height := top + len(g.Rows)*step + svgRowHeight + svgBottomMargin
+
+	var b strings.Builder
+	writeSVGHeader(&b, title, width, height, theme)
+
+	for col, l := range g.Columns {
+		if l != "" {
+			fmt.Fprintf(&b, "<text class=\"value\" x=\"%d\" y=\"%d\">%s</text>\n", left+col*step, top-4, html.EscapeString(l))
+		}
+	}
+
+	for row, cells := range g.Cells {
+		y := top + row*step
+		if g.Rows[row] != "" {
+			fmt.Fprintf(&b, "<text class=\"value\" x=\"%d\" y=\"%d\">%s</text>\n", svgPadding, y+cell-1, html.EscapeString(g.Rows[row]))
+		}
+
+		for col, v := range cells {
+			if v < 0 {
+				continue
+			}
+
+			writeSVGCell(&b, g, g.Level(v), left+col*step, y, cell, fmt.Sprintf("<title>%d</title>", v))
+		}
+	}
+
+	// legend, right-aligned under the grid
+	y := top + len(g.Rows)*step + svgGapAfterGrid
+	x := width - svgPadding - (heatLevels+1)*step - 30
+	fmt.Fprintf(&b, "<text class=\"value\" x=\"%d\" y=\"%d\" text-anchor=\"end\">%s</text>\n", x-4, y+cell-1, html.EscapeString(tr("calendar.less")))
+	for i := 0; i <= heatLevels; i++ {
+		writeSVGCell(&b, g, i, x+i*step, y, cell, "")
+	}
+	fmt.Fprintf(&b, "<text class=\"value\" x=\"%d\" y=\"%d\">%s</text>\n", x+(heatLevels+1)*step+2, y+cell-1, html.EscapeString(tr("calendar.more")))
+
+	b.WriteString("</svg>\n")
+
+	return b.String()
+}
+
+// writeSVGCell draws one heatmap cell of the given size at x, y: a square, or for
+// Grid.Circles a circle whose radius grows with the level
+func writeSVGCell(b *strings.Builder, g *Grid, level, x, y, size int, inner string) {
+	if !g.Circles {
+		fmt.Fprintf(b, "<rect class=\"l%d\" x=\"%d\" y=\"%d\" rx=\"2\" width=\"%d\" height=\"%d\">%s</rect>\n", level, x, y, size, size, inner)
+		return
+	}
+
+	r := float64(size) / 2 * float64(level+1) / float64(heatLevels+1)
+	fmt.Fprintf(b, "<circle class=\"l%d\" cx=\"%d\" cy=\"%d\" r=\"%.1f\">%s</circle>\n", level, x+size/2, y+size/2, r, inner)
+}
@@ -0,0 +1,70 @@
+package writer
+
+import "strings"
+
+// MakeMarkdownTable renders a Card as GitHub-flavored Markdown tables, one per group,
+// so the columns line up regardless of the font. Stat groups have a name and a value
+// column; the other groups also get a progress bar and a percent column. Heatmaps
+// have no rows and keep their fixed-width block.
+func MakeMarkdownTable(c *Card, version string) string {
+	if c == nil {
+		return ""
+	}
+
+	if c.Grid != nil {
+		return makeGridBlock(c.Title, c.Grid)
+	}
+
+	var b strings.Builder
+	b.WriteString("**")
+	b.WriteString(c.Title)
+	b.WriteString("**\n\n")
+	for _, g := range c.Groups {
+		name := tr("table.name")
+		if g.Label != "" {
+			name = strings.TrimSuffix(g.Label, ":")
+		}
+
+		if len(g.Rows) == 0 {
+			if g.Label != "" {
+				b.WriteString(g.Label + "\n\n")
+			}
+			b.WriteString(tr("noData") + "\n\n")
+			continue
+		}
+
+		if g.Stats {
+			writeTableRow(&b, name, tr("table.value"))
+			b.WriteString("| :--- | :--- |\n")
+			for _, r := range g.Rows {
+				writeTableRow(&b, strings.TrimSuffix(r.Name, ":"), r.Description)
+			}
+			b.WriteString("\n")
+			continue
+		}
+
+		writeTableRow(&b, name, tr("table.value"), tr("table.bar"), tr("table.percent"))
+		b.WriteString("| :--- | :--- | :--- | ---: |\n")
+		for _, r := range g.Rows {
+			writeTableRow(&b, r.Name, r.Description, "`"+makeProgressBar(r.barPercent(), version)+"`", formatDecimal("%.2f%%", r.Percent))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func writeTableRow(b *strings.Builder, cells ...string) {
+	b.WriteString("|")
+	for _, c := range cells {
+		b.WriteString(" ")
+		b.WriteString(tableCell(c))
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+}
+
+// tableCell escapes the characters that would end a Markdown table cell
+func tableCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
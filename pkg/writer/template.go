@@ -0,0 +1,93 @@
+package writer
+
+import (
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/thanhhaudev/github-stats/pkg/wakatime"
+)
+
+// TemplateData is the view model passed to a user-supplied TEMPLATE_FILE.
+// Card fields are nil when the metric has no data, so guard them with {{with}}.
+type TemplateData struct {
+	Commits           TemplateCommits
+	Streak            StreakHistory
+	Languages         *Card // LANGUAGES_AND_TOOLS
+	LanguagePerRepo   *Card // LANGUAGE_PER_REPO
+	CommitDaysOfWeek  *Card // COMMIT_DAYS_OF_WEEK
+	CommitTimesOfDay  *Card // COMMIT_TIMES_OF_DAY
+	CommitHours       *Card // COMMIT_HOURS
+	CommitsPerYear    *Card // COMMITS_PER_YEAR
+	CommitsPerQuarter *Card // COMMITS_PER_QUARTER
+	CommitCalendar    *Card // COMMIT_CALENDAR, a heatmap Grid
+	CommitPunchCard   *Card // COMMIT_PUNCH_CARD, a heatmap Grid
+	CommitSizes       *Card // COMMIT_SIZES
+	CommitTrend       *Card // COMMIT_TREND
+	CodeChurn         *Card // CODE_CHURN
+	TopRepositories   *Card // TOP_REPOSITORIES
+	ProfileSummary    *Card // PROFILE_SUMMARY
+	CodingStreak      *Card // CODING_STREAK
+	WakaTime          *Card // WAKATIME_SPENT_TIME, one group per WAKATIME_DATA entry
+	WakaTimeDaily     *Card // WAKATIME_DAILY
+	WakaTimeGoals     *Card // WAKATIME_GOALS
+	WakaTimeAllTime   *wakatime.AllTimeSinceTodayStats
+	AI                *Card // WAKATIME_AI_STATS
+	LastUpdated       time.Time
+
+	// Metrics holds the built-in output of every SHOW_METRICS entry, keyed by metric name
+	Metrics map[string]string
+}
+
+// TemplateCommits holds the commit totals
+type TemplateCommits struct {
+	Total     int
+	Yearly    map[int]int
+	Quarterly map[string]int
+	Weekdays  map[string]int
+}
+
+// TemplateFuncs returns the helper functions available inside a template.
+// progressBar and formatRow take an optional metric name and draw the bar in the
+// style barStyle returns for it; without one they use barStyle("").
+func TemplateFuncs(barStyle func(metric string) string) template.FuncMap {
+	return template.FuncMap{
+		"progressBar": func(p float64, metric ...string) string {
+			return makeProgressBar(p, templateBarStyle(barStyle, metric))
+		},
+		"formatRow": func(d Data, metric ...string) string {
+			return strings.TrimPrefix(formatData(d, templateBarStyle(barStyle, metric)), "\n")
+		},
+		"humanizeCount": func(n int) string {
+			return humanizeCount(int64(n))
+		},
+		"addCommas":     formatNumber,
+		"formatTime":    formatTime,
+		"formatPercent": formatPercent,
+	}
+}
+
+// templateBarStyle returns the bar style for the optional metric argument of a template helper
+func templateBarStyle(barStyle func(metric string) string, metric []string) string {
+	if len(metric) == 0 {
+		return barStyle("")
+	}
+
+	return barStyle(metric[0])
+}
+
+// MakeTemplate executes a user-supplied text/template against the view model.
+// barStyle returns the progress bar style of a metric, or the default style for "".
+func MakeTemplate(name, text string, data *TemplateData, barStyle func(metric string) string) (string, error) {
+	t, err := template.New(name).Funcs(TemplateFuncs(barStyle)).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,134 @@
+package writer
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/thanhhaudev/github-stats/pkg/github"
+)
+
+// sparkLevels are the glyphs of the COMMIT_TREND sparkline, lowest first
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// MakeCommitTrend returns a sparkline of the commits in each of the last window months,
+// or weeks with byWeek, followed by the lowest, highest and average count
+func MakeCommitTrend(commits []github.Commit, window int, byWeek bool, now time.Time) string {
+	c := CommitTrendCard(commits, window, byWeek, now)
+	if c == nil {
+		return ""
+	}
+
+	rows := c.Groups[1].Rows
+	counts := make([]int, len(rows))
+	for i, r := range rows {
+		counts[i] = r.Count
+	}
+
+	lines := []string{
+		makeSparkline(counts) + "  " + rows[0].Name + " – " + rows[len(rows)-1].Name + "\n",
+	}
+	for _, r := range c.Groups[0].Rows {
+		lines = append(lines, formatStatLine(r.Name, r.Description))
+	}
+
+	return makeStatBlock(c.Title, lines...)
+}
+
+// CommitTrendCard returns the commits in each of the last window months, or weeks with
+// byWeek, up to now as a Card. The first group holds the lowest, highest and average
+// count; the second one row per period, oldest first. Weeks start on Sunday.
+func CommitTrendCard(commits []github.Commit, window int, byWeek bool, now time.Time) *Card {
+	if len(commits) == 0 || window <= 0 {
+		return nil
+	}
+
+	y, m, d := now.Date()
+	start := time.Date(y, m, 1, 0, 0, 0, 0, now.Location()).AddDate(0, -(window - 1), 0)
+	period := func(i int) time.Time { return start.AddDate(0, i, 0) }
+	titleKey, unitKey := "trend.months", "trend.perMonth"
+	if byWeek {
+		sunday := time.Date(y, m, d-int(now.Weekday()), 0, 0, 0, 0, now.Location())
+		start = sunday.AddDate(0, 0, -7*(window-1))
+		period = func(i int) time.Time { return start.AddDate(0, 0, 7*i) }
+		titleKey, unitKey = "trend.weeks", "trend.perWeek"
+	}
+
+	counts := make([]int, window)
+	var total int
+	for _, c := range commits {
+		t := c.CommittedDate.In(now.Location())
+		if t.Before(start) || t.After(now) {
+			continue
+		}
+
+		i := window - 1
+		for i > 0 && t.Before(period(i)) {
+			i--
+		}
+		counts[i]++
+		total++
+	}
+
+	rows := make([]Data, window)
+	lowest, highest := 0, 0
+	for i, n := range counts {
+		name := formatMonth(period(i))
+		if byWeek {
+			name = formatDate(period(i))
+		}
+
+		var percent float64
+		if total > 0 {
+			percent = float64(n) / float64(total) * 100
+		}
+
+		rows[i] = Data{Name: name, Description: formatUnit(int64(n), "commit"), Percent: percent, Count: n}
+		if n < counts[lowest] {
+			lowest = i
+		}
+		if n > counts[highest] {
+			highest = i
+		}
+	}
+
+	return &Card{
+		Title: tr(titleKey, window),
+		Groups: []Group{
+			{
+				Rows: []Data{
+					{Name: tr("trend.lowest"), Description: tr("trend.when", formatUnit(int64(counts[lowest]), "commit"), rows[lowest].Name)},
+					{Name: tr("trend.highest"), Description: tr("trend.when", formatUnit(int64(counts[highest]), "commit"), rows[highest].Name)},
+					{Name: tr("trend.average"), Description: tr(unitKey, formatDecimal("%.1f", float64(total)/float64(window)))},
+				},
+				Stats: true,
+			},
+			{Rows: rows},
+		},
+	}
+}
+
+// makeSparkline returns one glyph per count, scaled from the lowest to the highest glyph by
+// the largest count
+func makeSparkline(counts []int) string {
+	top := 0
+	for _, n := range counts {
+		top = max(top, n)
+	}
+
+	var b strings.Builder
+	for _, n := range counts {
+		level := 0
+		if top > 0 {
+			level = int(math.Round(float64(n) / float64(top) * float64(len(sparkLevels)-1)))
+		}
+		b.WriteRune(sparkLevels[level])
+	}
+
+	return b.String()
+}
+
+// formatMonth returns the month of t with its year, such as "Jun 2025"
+func formatMonth(t time.Time) string {
+	return tr("month", currentLocale().ShortMonths[t.Month()-1], t.Year())
+}
@@ -25,6 +25,18 @@ type Data struct {
 	Hours       int
 	Minutes     int
 	Seconds     int
+	Color       string  // optional bar color (hex without #), used by graphical renderers
+	Count       int     // raw value behind Percent, used by charts
+	Fill        float64 // bar fill in percent when it differs from Percent, e.g. relative to the largest row
+}
+
+// barPercent returns how much of the row's progress bar is filled
+func (d Data) barPercent() float64 {
+	if d.Fill > 0 {
+		return d.Fill
+	}
+
+	return d.Percent
 }
 
 const (
@@ -36,55 +48,106 @@ const (
 
 type WeekTime int
 
+var weekTimeKeys = []string{"morning", "daytime", "evening", "night"}
+
+var timesOfDayEmoji = []string{
+	"🌅",
+	"🌞",
+	"🌆",
+	"🌙",
+}
+
 func (w WeekTime) String() string {
-	return longWeekTimeNames[w]
+	return tr("times." + weekTimeKeys[w])
 }
 
 // MakeLanguageAndToolList returns a list of languages and tools used in the repositories
 func MakeLanguageAndToolList(l map[string][2]interface{}, totalSize int) string {
-	if len(l) == 0 {
+	c := LanguageAndToolCard(l, totalSize)
+	if c == nil {
 		return ""
 	}
 
+	res := strings.Builder{}
+	for _, d := range c.Groups[0].Rows {
+		fmt.Fprintf(&res, "![%s](https://img.shields.io/badge/%s-%05.2f%%25-%s?&logo=%s&labelColor=151b23)\n", d.Name, d.Name, d.Percent, d.Color, d.Name)
+	}
+
+	return "**" + c.Title + "**\n\n" + res.String() + "\n\n"
+}
+
+// LanguageAndToolCard returns the languages used in the repositories as a Card, largest first
+func LanguageAndToolCard(l map[string][2]interface{}, totalSize int) *Card {
+	if len(l) == 0 {
+		return nil
+	}
+
 	// Create a map to store the sizes for sorting
 	sizeMap := make(map[string]int)
 	for key, value := range l {
 		sizeMap[key] = value[1].(int)
 	}
 
-	res := strings.Builder{}
+	var data []Data
 	for _, k := range sortMapByValue(sizeMap) {
 		c := l[k][0].(string)
-		s := l[k][1].(int)
-		fmt.Fprintf(&res, "![%s](https://img.shields.io/badge/%s-%05.2f%%25-%s?&logo=%s&labelColor=151b23)\n", k, k, float64(s)/float64(totalSize)*100, c[1:], k)
+		p := float64(l[k][1].(int)) / float64(totalSize) * 100
+		data = append(data, Data{
+			Name:        k,
+			Description: formatPercent(p),
+			Percent:     p,
+			Color:       strings.TrimPrefix(c, "#"),
+		})
 	}
 
-	return "**💬 Languages & Tools**\n\n" + res.String() + "\n\n"
+	return &Card{
+		Title:  tr("languages.title"),
+		Groups: []Group{{Rows: data}},
+	}
 }
 
 // MakeWakaActivityList returns a list of activities
 func MakeWakaActivityList(s *wakatime.Stats, i []string, version string) string {
+	return makeBlock(WakaActivityCard(s, i), version)
+}
+
+// WakaActivityCard returns one group per requested WakaTime breakdown, headlined by the total time in the range
+func WakaActivityCard(s *wakatime.Stats, i []string) *Card {
 	if s == nil || len(i) == 0 {
-		return ""
+		return nil
 	}
 
-	var res string
+	var groups []Group
 	for _, v := range i {
 		switch v {
 		case "LANGUAGES":
-			res = res + "💬 Languages:" + makeList(buildWakaData(s.Data.Languages), version) + "\n"
+			groups = append(groups, Group{Label: tr("waka.languages"), Rows: buildWakaData(s.Data.Languages)})
 		case "EDITORS":
-			res = res + "📝 Editors:" + makeList(buildWakaData(s.Data.Editors), version) + "\n"
+			groups = append(groups, Group{Label: tr("waka.editors"), Rows: buildWakaData(s.Data.Editors)})
 		case "OPERATING_SYSTEMS":
-			res = res + "💻 Operating Systems:" + makeList(buildWakaData(s.Data.OperatingSystems), version) + "\n"
+			groups = append(groups, Group{Label: tr("waka.os"), Rows: buildWakaData(s.Data.OperatingSystems)})
 		case "PROJECTS":
-			res = res + "📦 Projects:" + makeList(buildWakaData(s.Data.Projects), version) + "\n"
+			groups = append(groups, Group{Label: tr("waka.projects"), Rows: buildWakaData(s.Data.Projects)})
+		case "CATEGORIES":
+			groups = append(groups, Group{Label: tr("waka.categories"), Rows: buildWakaData(s.Data.Categories)})
+		case "MACHINES":
+			groups = append(groups, Group{Label: tr("waka.machines"), Rows: buildWakaData(s.Data.Machines)})
+		case "DEPENDENCIES":
+			groups = append(groups, Group{Label: tr("waka.dependencies"), Rows: buildWakaData(s.Data.Dependencies)})
+		case "BRANCHES":
+			groups = append(groups, Group{Label: tr("waka.branches"), Rows: buildWakaData(s.Data.Branches)})
 		}
 	}
 
-	res = strings.TrimSuffix(res, "\n") // trim last newline
+	c := &Card{
+		Title:  tr("waka." + s.Data.Range),
+		Groups: groups,
+	}
+	if s.Data.TotalSeconds > 0 {
+		c.Headline = formatSeconds(s.Data.TotalSeconds)
+	}
 
-	return fmt.Sprintf("**%s**\n\n", wakaRangeNames[s.Data.Range]) + "```text\n" + res + "```\n\n"
+	return c
 }
 
 func buildWakaData(i []wakatime.StatsItem) []Data {
@@ -114,7 +177,7 @@ func buildWakaData(i []wakatime.StatsItem) []Data {
 
 	if otherData.Percent > 0 {
 		data = append(data, Data{
-			Name:        "Others",
+			Name:        tr("waka.others"),
 			Description: formatTime(otherData.Hours, otherData.Minutes),
 			Percent:     otherData.Percent,
 		})
@@ -125,18 +188,54 @@ func buildWakaData(i []wakatime.StatsItem) []Data {
 
 // MakeLastUpdatedOn returns a string with the last updated time
 func MakeLastUpdatedOn(t string) string {
-	return fmt.Sprintf("\n\n⏳ *Last updated on %s*", t)
+	return "\n\n" + tr("lastUpdated", t)
+}
+
+// Streak is a run of days from Start to End, both included
+type Streak struct {
+	Start time.Time
+	End   time.Time
+	Days  int
+}
+
+// StreakHistory holds the commit streaks shown by CODING_STREAK
+type StreakHistory struct {
+	Current    int
+	Longest    int
+	Top        []Streak  // longest first
+	LongestGap Streak    // longest run of days without commits; zero without a gap
+	LastCommit time.Time // zero without commits
 }
 
 // MakeCodingStreakList returns coding streak statistics from commit data and WakaTime all-time data.
-func MakeCodingStreakList(s *wakatime.AllTimeSinceTodayStats, currentStreak, longestStreak int) string {
-	if s == nil && currentStreak == 0 && longestStreak == 0 {
-		return ""
+func MakeCodingStreakList(s *wakatime.AllTimeSinceTodayStats, h StreakHistory, now time.Time) string {
+	return makeBlock(CodingStreakCard(s, h, now), "")
+}
+
+// CodingStreakCard returns the coding streak statistics as a Card of stat rows. The days since
+// the last commit and the longest gap follow the streaks when known, and the top streaks are
+// listed in a second group when there is more than one.
+func CodingStreakCard(s *wakatime.AllTimeSinceTodayStats, h StreakHistory, now time.Time) *Card {
+	if s == nil && h.Current == 0 && h.Longest == 0 {
+		return nil
+	}
+
+	rows := []Data{
+		{Name: tr("streak.current"), Description: formatUnit(int64(h.Current), "day")},
+		{Name: tr("streak.longest"), Description: formatUnit(int64(h.Longest), "day")},
+	}
+
+	if !h.LastCommit.IsZero() {
+		days := daysSince(h.LastCommit, now)
+		since := tr("streak.today")
+		if days > 0 {
+			since = tr("streak.ago", formatUnit(int64(days), "day"))
+		}
+		rows = append(rows, Data{Name: tr("streak.lastCommit"), Description: since})
 	}
 
-	lines := []string{
-		formatCountLine("🔥 Current Streak:", int64(currentStreak), "day", "days"),
-		formatCountLine("🏆 Longest Streak:", int64(longestStreak), "day", "days"),
+	if h.LongestGap.Days > 0 {
+		rows = append(rows, Data{Name: tr("streak.longestGap"), Description: formatUnit(int64(h.LongestGap.Days), "day") + " (" + formatDateRange(h.LongestGap) + ")"})
 	}
 
 	if s != nil {
@@ -158,26 +257,65 @@ func MakeCodingStreakList(s *wakatime.AllTimeSinceTodayStats, currentStreak, lon
 			consistencyPercent = (float64(activeDays) / float64(totalDays)) * 100
 		}
 
-		lines = append(lines,
-			formatStatLine("📊 Daily Average:", fmt.Sprintf("%d hrs %d mins", dailyAvgHours, dailyAvgMinutes)),
-			formatStatLine("💪 Total Coding Time:", s.Data.Text),
-			formatStatLine("🎯 Coding Consistency:", fmt.Sprintf("%.1f%%", consistencyPercent)),
-			formatCountLine("📅 Active Days:", int64(activeDays), "day", "days"),
+		rows = append(rows,
+			Data{Name: tr("streak.dailyAverage"), Description: tr("streak.hoursMinutes", dailyAvgHours, dailyAvgMinutes)},
+			Data{Name: tr("streak.totalTime"), Description: s.Data.Text},
+			Data{Name: tr("streak.consistency"), Description: formatDecimal("%.1f%%", consistencyPercent)},
+			Data{Name: tr("streak.activeDays"), Description: formatUnit(int64(activeDays), "day")},
 		)
 	}
 
-	return makeStatBlock("📈 Coding Streak", lines...)
+	groups := []Group{{Rows: rows, Stats: true}}
+	if len(h.Top) > 1 {
+		top := make([]Data, 0, len(h.Top))
+		for i, streak := range h.Top {
+			top = append(top, Data{
+				Name:        tr("streak.rank", i+1, formatUnit(int64(streak.Days), "day")),
+				Description: formatDateRange(streak),
+			})
+		}
+		groups = append(groups, Group{Label: tr("streak.top"), Rows: top, Stats: true})
+	}
+
+	return &Card{
+		Title:    tr("streak.title"),
+		Groups:   groups,
+		Headline: formatUnit(int64(h.Current), "day"),
+	}
+}
+
+// formatDateRange returns the dates a streak covers, or its only date for a single day
+func formatDateRange(s Streak) string {
+	if s.Days <= 1 {
+		return formatDate(s.Start)
+	}
+
+	return formatDate(s.Start) + " – " + formatDate(s.End)
+}
+
+// daysSince returns the calendar days from the day of t to the day of now, in now's location
+func daysSince(t, now time.Time) int {
+	t = t.In(now.Location())
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, now.Location())
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	return int(math.Round(today.Sub(day).Hours() / 24))
 }
 
 // MakeAIStatsList returns a summary of AI vs human coding attribution from WakaTime.
 func MakeAIStatsList(aiAdd, humanAdd, inTokens, outTokens int64, avgPrompt float64, wakaRange string) string {
+	return makeBlock(AIStatsCard(aiAdd, humanAdd, inTokens, outTokens, avgPrompt, wakaRange), "")
+}
+
+// AIStatsCard returns the AI vs human coding attribution as a Card of stat rows
+func AIStatsCard(aiAdd, humanAdd, inTokens, outTokens int64, avgPrompt float64, wakaRange string) *Card {
 	if aiAdd == 0 && inTokens == 0 {
-		return ""
+		return nil
 	}
 
-	title, ok := aiFootprintTitles[wakaRange]
-	if !ok {
-		title = aiFootprintTitles["all_time"]
+	titleKey := "ai." + wakaRange
+	if _, ok := localeEN.Messages[titleKey]; !ok {
+		titleKey = "ai.all_time"
 	}
 
 	totalAdd := aiAdd + humanAdd
@@ -186,24 +324,27 @@ func MakeAIStatsList(aiAdd, humanAdd, inTokens, outTokens int64, avgPrompt float
 		aiContribution = float64(aiAdd) / float64(totalAdd) * 100
 	}
 
-	lines := []string{
-		formatCountLineFormatted("🤖 Generated by AI:", aiAdd, "line", "lines", humanizeCount),
-		formatCountLineFormatted("👤 Written by Hand:", humanAdd, "line", "lines", humanizeCount),
-		formatStatLine("📊 AI Contribution:", fmt.Sprintf("%.1f%%", aiContribution)),
-		formatStatLine("🔤 Tokens In / Out:", humanizeCount(inTokens)+" / "+humanizeCount(outTokens)),
+	rows := []Data{
+		{Name: tr("ai.generated"), Description: formatUnitFormatted(aiAdd, "line", humanizeCount)},
+		{Name: tr("ai.handwritten"), Description: formatUnitFormatted(humanAdd, "line", humanizeCount)},
+		{Name: tr("ai.contribution"), Description: formatDecimal("%.1f%%", aiContribution)},
+		{Name: tr("ai.tokens"), Description: humanizeCount(inTokens) + " / " + humanizeCount(outTokens)},
 	}
 
 	if avgPrompt > 0 {
-		lines = append(lines, formatCountLineFormatted("💬 Average Prompt:", int64(math.Round(avgPrompt)), "char", "chars", humanizeCount))
+		rows = append(rows, Data{Name: tr("ai.avgPrompt"), Description: formatUnitFormatted(int64(math.Round(avgPrompt)), "char", humanizeCount)})
 	}
 
-	return makeStatBlock(title, lines...)
+	return &Card{
+		Title:  tr(titleKey),
+		Groups: []Group{{Rows: rows, Stats: true}},
+	}
 }
 
-// humanizeCount formats large numbers with compact suffixes; falls back to addCommas under 1,000.
+// humanizeCount formats large numbers with compact suffixes; falls back to formatNumber under 1,000.
 func humanizeCount(n int64) string {
 	if n < 1_000 {
-		return addCommas(int(n))
+		return formatNumber(int(n))
 	}
 
 	type suffix struct {
@@ -226,89 +367,100 @@ func humanizeCount(n int64) string {
 		scaled := float64(n) / s.value
 		if scaled >= 999.95 && i > 0 {
 			next := suffixes[i-1]
-			return fmt.Sprintf("%.1f%s", float64(n)/next.value, next.label)
+			return formatDecimal("%.1f", float64(n)/next.value) + next.label
 		}
 
-		return fmt.Sprintf("%.1f%s", scaled, s.label)
+		return formatDecimal("%.1f", scaled) + s.label
 	}
 
-	return addCommas(int(n))
+	return formatNumber(int(n))
 }
 
 // MakeCommitTimesOfDayList returns a list of commits made during different times of the day
-func MakeCommitTimesOfDayList(commits []github.Commit, simplifyTitle bool, version string) string {
+func MakeCommitTimesOfDayList(commits []github.Commit, periods []DayPeriod, simplifyTitle bool, version string) string {
+	return makeBlock(CommitTimesOfDayCard(commits, periods, simplifyTitle), version)
+}
+
+// CommitTimesOfDayCard returns the commits per time of day as a Card, one row per period.
+// Without periods the DefaultDayPeriods are used.
+func CommitTimesOfDayCard(commits []github.Commit, periods []DayPeriod, simplifyTitle bool) *Card {
 	if len(commits) == 0 {
-		return ""
+		return nil
 	}
 
-	timeRanges := map[WeekTime][2]int{
-		Morning: {6, 12},
-		Daytime: {12, 18},
-		Evening: {18, 23},
-		Night:   {23, 6},
+	if len(periods) == 0 {
+		periods = DefaultDayPeriods()
 	}
 
 	total := len(commits)
-	counts := make(map[WeekTime]int)
+	counts := make([]int, len(periods))
 
 	for _, commit := range commits {
 		hour := commit.CommittedDate.Hour()
-		for period, rangeHours := range timeRanges {
-			if rangeHours[0] < rangeHours[1] { // if the range is not across midnight
-				if hour >= rangeHours[0] && hour < rangeHours[1] {
-					counts[period]++
-					break
-				}
-			} else { // if the range is across midnight
-				if hour >= rangeHours[0] || hour < rangeHours[1] {
-					counts[period]++
-					break
-				}
+		for i, p := range periods {
+			if p.Contains(hour) {
+				counts[i]++
+				break
 			}
 		}
 	}
 
 	var data []Data
-	var topWeek WeekTime
-	var topVal int
-
-	for i, n := range longWeekTimeNames {
-		weekTime := WeekTime(i)
-		weekCommit := counts[weekTime]
-		if weekCommit > topVal {
-			topVal = weekCommit
-			topWeek = weekTime
+	var top, topVal, early, late int
+
+	for i, p := range periods {
+		if counts[i] > topVal {
+			topVal = counts[i]
+			top = i
+		}
+
+		if p.Early() {
+			early += counts[i]
+		} else {
+			late += counts[i]
+		}
+
+		name := p.Name
+		if p.Emoji != "" {
+			name = p.Emoji + " " + name
 		}
 
 		data = append(data, Data{
-			Name: fmt.Sprintf("%s %s", timesOfDayEmoji[weekTime], n),
-			Description: fmt.Sprintf("%s %s", addCommas(weekCommit), func() string {
-				if weekCommit > 1 {
-					return "commits"
-				}
-
-				return "commit"
-			}()),
-			Percent: float64(weekCommit) / float64(total) * 100,
+			Name:        name,
+			Description: formatUnit(int64(counts[i]), "commit"),
+			Percent:     float64(counts[i]) / float64(total) * 100,
+			Count:       counts[i],
 		})
 	}
 
-	status := longTimesOfDayStatuses[topWeek]
+	title := tr("times.title", periods[top].Status)
+	if periods[top].Status == "" {
+		title = tr("times.activeTitle", periods[top].Name)
+	}
+
 	if simplifyTitle {
-		if (data[0].Percent + data[1].Percent) > (data[2].Percent + data[3].Percent) {
-			status = timesOfDayStatuses[0]
+		if early > late {
+			title = tr("times.title", tr("times.status.early"))
 		} else {
-			status = timesOfDayStatuses[1]
+			title = tr("times.title", tr("times.status.late"))
 		}
 	}
 
-	return fmt.Sprintf("**🕒 I'm %s**\n\n", status) + "```text" + makeList(data, version) + "```\n\n"
+	return &Card{
+		Title:  title,
+		Groups: []Group{{Rows: data}},
+	}
 }
 
 // MakeCommitDaysOfWeekList returns a list of commits made on each day of the week
 func MakeCommitDaysOfWeekList(wd map[time.Weekday]int, total int, version string) string {
+	return makeBlock(CommitDaysOfWeekCard(wd, total), version)
+}
+
+// CommitDaysOfWeekCard returns the commits per weekday as a Card
+func CommitDaysOfWeekCard(wd map[time.Weekday]int, total int) *Card {
 	if total == 0 {
-		return ""
+		return nil
 	}
 
 	var (
@@ -330,29 +482,32 @@ func MakeCommitDaysOfWeekList(wd map[time.Weekday]int, total int, version string
 	for _, weekday := range weekdays {
 		if wd[weekday] > topVal {
 			topVal = wd[weekday]
-			topName = weekday.String()
+			topName = weekdayName(weekday)
 		}
 
 		data = append(data, Data{
-			Name: weekday.String(),
-			Description: fmt.Sprintf("%s %s", addCommas(wd[weekday]), func() string {
-				if wd[weekday] > 1 {
-					return "commits"
-				}
-
-				return "commit"
-			}()),
-			Percent: float64(wd[weekday]) / float64(total) * 100,
+			Name:        weekdayName(weekday),
+			Description: formatUnit(int64(wd[weekday]), "commit"),
+			Percent:     float64(wd[weekday]) / float64(total) * 100,
+			Count:       wd[weekday],
 		})
 	}
 
-	return fmt.Sprintf("**📅 I'm Most Productive on %s**\n\n", topName) + "```text" + makeList(data, version) + "```\n\n"
+	return &Card{
+		Title:  tr("days.title", topName),
+		Groups: []Group{{Rows: data}},
+	}
 }
 
 // MakeLanguagePerRepoList returns a list of languages and the percentage of repositories that use them
 func MakeLanguagePerRepoList(r []github.Repository, version string) string {
+	return makeBlock(LanguagePerRepoCard(r), version)
+}
+
+// LanguagePerRepoCard returns the primary language share across repositories as a Card
+func LanguagePerRepoCard(r []github.Repository) *Card {
 	if len(r) == 0 {
-		return ""
+		return nil
 	}
 
 	var (
@@ -373,7 +528,7 @@ func MakeLanguagePerRepoList(r []github.Repository, version string) string {
 	}
 
 	if len(repos) == 0 {
-		return ""
+		return nil
 	}
 
 	// Create a list of Data structs
@@ -385,61 +540,17 @@ func MakeLanguagePerRepoList(r []github.Repository, version string) string {
 		}
 
 		data = append(data, Data{
-			Name: name,
-			Description: fmt.Sprintf("%s %s", addCommas(num), func() string {
-				if num > 1 {
-					return "repos"
-				}
-				return "repo"
-			}()),
-			Percent: float64(num) / count * 100,
+			Name:        name,
+			Description: formatUnit(int64(num), "repo"),
+			Percent:     float64(num) / count * 100,
+			Count:       num,
 		})
 	}
 
-	return fmt.Sprintf("**🔥 I Mostly Code in %s**\n\n", topName) + "```text" + makeList(data, version) + "```\n\n"
-}
-
-func makeList(d []Data, version string) string {
-	if len(d) == 0 {
-		return "\nNo data available\n"
+	return &Card{
+		Title:  tr("repo.title", topName),
+		Groups: []Group{{Rows: data}},
 	}
-
-	var b strings.Builder
-
-	for _, val := range d {
-		b.WriteString(formatData(val, version))
-	}
-
-	b.WriteString("\n")
-
-	return b.String()
-}
-
-func makeProgressBar(p float64) string {
-	filledChar := "█"
-	emptyChar := "░"
-
-	filledLength := int(math.Round(p / (100 / graphLength)))
-	emptyLength := graphLength - filledLength
-
-	return strings.Repeat(filledChar, filledLength) + strings.Repeat(emptyChar, emptyLength)
-}
-
-func makeProgressBarV2(p float64) string {
-	filledChar := "🟩"
-	halfFilledChar := "🟨"
-	emptyChar := "⬜"
-
-	percentagePerBlock := float64(100 / graphLength)
-	filledLength := int(math.Floor(p / percentagePerBlock))
-	remainingPercentage := p - (float64(filledLength) * percentagePerBlock)
-	halfFilledLength := 0
-	if remainingPercentage > 0 {
-		halfFilledLength = 1
-	}
-	emptyLength := graphLength - filledLength - halfFilledLength
-
-	return strings.Repeat(filledChar, filledLength) + strings.Repeat(halfFilledChar, halfFilledLength) + strings.Repeat(emptyChar, emptyLength)
 }
 
 func formatData(data Data, version string) string {
@@ -454,11 +565,7 @@ func formatData(data Data, version string) string {
 	b.WriteString(d)
 	b.WriteString(strings.Repeat(" ", max(0, descriptionColumnWidth-displayWidth(d))))
 
-	if version == "2" {
-		b.WriteString(makeProgressBarV2(data.Percent))
-	} else {
-		b.WriteString(makeProgressBar(data.Percent))
-	}
+	b.WriteString(makeProgressBar(data.barPercent(), version))
 
 	b.WriteString("   ")
 	b.WriteString(formatPercent(data.Percent))
@@ -467,7 +574,7 @@ func formatData(data Data, version string) string {
 }
 
 func formatPercent(p float64) string {
-	return fmt.Sprintf("%05.2f%%", p)
+	return formatDecimal("%05.2f%%", p)
 }
 
 func max(a, b int) int {
@@ -498,19 +605,6 @@ func truncateString(s string, l int) string {
 	return b.String()
 }
 
-func addCommas(n int) string {
-	str := fmt.Sprintf("%d", n)
-	var result []string
-	for i, c := range str {
-		if (len(str)-i)%3 == 0 && i != 0 {
-			result = append(result, ",")
-		}
-		result = append(result, string(c))
-	}
-
-	return strings.Join(result, "")
-}
-
 func makeStatBlock(title string, lines ...string) string {
 	var b strings.Builder
 	b.WriteString("**")
@@ -578,41 +672,18 @@ func isWideRune(r rune) bool {
 	}
 }
 
-func formatCountLine(label string, value int64, singular, plural string) string {
-	return formatCountLineFormatted(label, value, singular, plural, func(n int64) string {
-		return addCommas(int(n))
-	})
-}
-
-func formatCountLineFormatted(label string, value int64, singular, plural string, format func(int64) string) string {
-	unit := plural
-	if value == 1 {
-		unit = singular
-	}
-
-	return formatStatLine(label, fmt.Sprintf("%s %s", format(value), unit))
+func formatCountLine(label string, value int64, unit string) string {
+	return formatStatLine(label, formatUnit(value, unit))
 }
 
 func formatTime(hours, minutes int) string {
 	var result string
 	if hours > 0 {
-		result += fmt.Sprintf("%d %s", hours, func() string {
-			if hours > 1 {
-				return "hrs"
-			}
-
-			return "hr"
-		}())
+		result += formatUnit(int64(hours), "hour")
 	}
 
 	if minutes > 0 {
-		result += fmt.Sprintf(" %d %s", minutes, func() string {
-			if minutes > 1 {
-				return "mins"
-			}
-
-			return "min"
-		}())
+		result += " " + formatUnit(int64(minutes), "minute")
 	}
 
 	return strings.TrimSpace(result)
@@ -53,34 +53,38 @@ func TestFormatDataDescriptionStartsAtStatValueColumn(t *testing.T) {
 
 func TestFormatCountLine(t *testing.T) {
 	tests := []struct {
-		name     string
-		label    string
-		value    int64
-		singular string
-		plural   string
-		want     string
+		name  string
+		label string
+		value int64
+		unit  string
+		want  string
 	}{
 		{
-			name:     "uses singular form for one",
-			label:    "🔥 Current Streak:",
-			value:    1,
-			singular: "day",
-			plural:   "days",
-			want:     "1 day",
+			name:  "uses singular form for one",
+			label: "🔥 Current Streak:",
+			value: 1,
+			unit:  "day",
+			want:  "1 day",
 		},
 		{
-			name:     "uses plural form for many",
-			label:    "📅 Active Days:",
-			value:    2,
-			singular: "day",
-			plural:   "days",
-			want:     "2 days",
+			name:  "uses plural form for many",
+			label: "📅 Active Days:",
+			value: 2,
+			unit:  "day",
+			want:  "2 days",
+		},
+		{
+			name:  "uses plural form for zero",
+			label: "📅 Active Days:",
+			value: 0,
+			unit:  "day",
+			want:  "0 days",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := formatCountLine(tt.label, tt.value, tt.singular, tt.plural)
+			got := formatCountLine(tt.label, tt.value, tt.unit)
 			if !strings.Contains(got, tt.label) || !strings.Contains(got, tt.want) {
 				t.Fatalf("unexpected line:\nwant label %q and value %q\ngot  %q", tt.label, tt.want, got)
 			}
@@ -90,8 +94,8 @@ func TestFormatCountLine(t *testing.T) {
 
 func TestMakeStatBlock(t *testing.T) {
 	got := makeStatBlock("📈 Coding Streak",
-		formatCountLine("🔥 Current Streak:", 1, "day", "days"),
-		formatCountLine("🏆 Longest Streak:", 2, "day", "days"),
+		formatCountLine("🔥 Current Streak:", 1, "day"),
+		formatCountLine("🏆 Longest Streak:", 2, "day"),
 	)
 
 	if !strings.Contains(got, "**📈 Coding Streak**") {
@@ -241,7 +245,7 @@ func TestMakeCodingStreakList(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := MakeCodingStreakList(tt.stats, tt.currentStreak, tt.longestStreak)
+			result := MakeCodingStreakList(tt.stats, StreakHistory{Current: tt.currentStreak, Longest: tt.longestStreak}, time.Now())
 
 			if tt.shouldBeEmpty {
 				if result != "" {
@@ -264,6 +268,42 @@ func TestMakeCodingStreakList(t *testing.T) {
 	}
 }
 
+func TestCodingStreakHistory(t *testing.T) {
+	now := time.Date(2026, 5, 20, 12, 0, 0, 0, time.UTC)
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC) }
+	h := StreakHistory{
+		Current: 2,
+		Longest: 21,
+		Top: []Streak{
+			{Start: day(2, 2), End: day(2, 22), Days: 21},
+			{Start: day(4, 1), End: day(4, 9), Days: 9},
+			{Start: day(5, 17), End: day(5, 17), Days: 1},
+		},
+		LongestGap: Streak{Start: day(2, 23), End: day(3, 14), Days: 20},
+		LastCommit: time.Date(2026, 5, 17, 23, 0, 0, 0, time.UTC),
+	}
+
+	text := MakeCodingStreakList(nil, h, now)
+	for _, want := range []string{
+		"**📈 Coding Streak**\n\n```text\n🔥 Current Streak:        2 days\n🏆 Longest Streak:        21 days\n",
+		"🕒 Last Commit:           3 days ago\n",
+		"💤 Longest Gap:           20 days (Feb 23, 2026 – Mar 14, 2026)\n",
+		"\n\nTop Streaks\n1. 21 days                Feb 2, 2026 – Feb 22, 2026\n2. 9 days",
+		"\n3. 1 day                  May 17, 2026\n```",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected streak history to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	h.Top = h.Top[:1]
+	h.LastCommit = time.Date(2026, 5, 20, 1, 0, 0, 0, time.UTC)
+	text = MakeCodingStreakList(nil, h, now)
+	if strings.Contains(text, "Top Streaks") || !strings.Contains(text, "🕒 Last Commit:           today\n") {
+		t.Errorf("expected a single streak to stay out of the top list and a commit today, got:\n%s", text)
+	}
+}
+
 func TestMakeAIStatsList(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -309,7 +349,7 @@ func TestMakeAIStatsList(t *testing.T) {
 			},
 		},
 		{
-			name:      "small token counts use addCommas; prompt row hidden when both zero",
+			name:      "small token counts use formatNumber; prompt row hidden when both zero",
 			aiAdd:     20,
 			inTokens:  999,
 			wakaRange: "all_time",
@@ -438,7 +478,7 @@ func TestMakeCommitTimesOfDayList(t *testing.T) {
 		{CommittedDate: time.Date(2026, 5, 8, 23, 30, 0, 0, time.UTC)},
 	}
 
-	got := MakeCommitTimesOfDayList(commits, false, "1")
+	got := MakeCommitTimesOfDayList(commits, nil, false, "1")
 
 	want := strings.Join([]string{
 		"**🕒 I'm An Early Bird 🐤**",
@@ -457,3 +497,995 @@ func TestMakeCommitTimesOfDayList(t *testing.T) {
 		t.Fatalf("unexpected output:\nwant:\n%s\n\ngot:\n%s", want, got)
 	}
 }
+
+func TestCommitTimesOfDayCardWithCustomPeriods(t *testing.T) {
+	commits := []github.Commit{
+		{CommittedDate: time.Date(2026, 5, 8, 7, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 8, 23, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 9, 2, 0, 0, 0, time.UTC)},
+	}
+	periods, err := ParseDayPeriods("6-14|Early Shift|🌅; 14-22|Late Shift; 22-6|Night Shift|🌙|A Night Shifter 🦉")
+	if err != nil {
+		t.Fatalf("ParseDayPeriods returned error: %v", err)
+	}
+
+	card := CommitTimesOfDayCard(commits, periods, false)
+	if card.Title != "🕒 I'm A Night Shifter 🦉" {
+		t.Errorf("unexpected title %q", card.Title)
+	}
+
+	rows := card.Groups[0].Rows
+	if len(rows) != 3 || rows[0].Name != "🌅 Early Shift" || rows[1].Name != "Late Shift" || rows[2].Count != 2 {
+		t.Errorf("unexpected rows: %+v", rows)
+	}
+
+	if got := CommitTimesOfDayCard(commits[:1], periods, false).Title; got != "🕒 Most Active in the Early Shift" {
+		t.Errorf("expected the period name in the title without a status, got %q", got)
+	}
+	if got := CommitTimesOfDayCard(commits, periods, true).Title; got != "🕒 I'm A Night 🦉" {
+		t.Errorf("unexpected simplified title %q", got)
+	}
+}
+
+func TestParseDayPeriods(t *testing.T) {
+	periods, err := ParseDayPeriods("0-24|All day")
+	if err != nil || len(periods) != 1 || !periods[0].Contains(0) || !periods[0].Contains(23) {
+		t.Fatalf("expected a period covering the whole day, got %+v, %v", periods, err)
+	}
+
+	for _, def := range []string{"", "6|Morning", "6-6|Never", "6-25|Late", "6-12", "6-12|A;11-13|B", "6-12|A|b|c|d", "6-18|Day;18-5|Night"} {
+		if _, err := ParseDayPeriods(def); err == nil {
+			t.Errorf("expected an error for %q", def)
+		}
+	}
+}
+
+func TestCommitTimesOfDayCardSimplifiedTitleUsesPeriodHours(t *testing.T) {
+	commits := []github.Commit{
+		{CommittedDate: time.Date(2026, 5, 8, 20, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 8, 21, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 8, 9, 0, 0, 0, time.UTC)},
+	}
+	// the evening comes first, so splitting the periods by index would count it as early
+	periods, err := ParseDayPeriods("18-23|Evening;23-6|Night;6-12|Morning;12-18|Afternoon")
+	if err != nil {
+		t.Fatalf("ParseDayPeriods returned error: %v", err)
+	}
+
+	if got := CommitTimesOfDayCard(commits, periods, true).Title; got != "🕒 I'm A Night 🦉" {
+		t.Errorf("unexpected simplified title %q", got)
+	}
+}
+
+func TestCommitHoursCard(t *testing.T) {
+	commits := []github.Commit{
+		{CommittedDate: time.Date(2026, 5, 8, 0, 15, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 8, 21, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 9, 21, 59, 0, 0, time.UTC)},
+	}
+
+	card := CommitHoursCard(commits)
+	rows := card.Groups[0].Rows
+	if card.Title != "⏰ Busiest Hour: 21:00" || len(rows) != 24 {
+		t.Fatalf("unexpected card %q with %d rows", card.Title, len(rows))
+	}
+	if rows[0].Name != "00:00" || rows[0].Count != 1 || rows[21].Count != 2 || rows[21].Fill != 100 || rows[0].Fill != 50 || rows[12].Description != "0 commits" {
+		t.Errorf("unexpected rows: %+v", rows)
+	}
+
+	text := MakeCommitHoursList(commits, "1")
+	if !strings.Contains(text, "\n21:00"+strings.Repeat(" ", 21)+"2 commits") {
+		t.Errorf("unexpected histogram:\n%s", text)
+	}
+	if CommitHoursCard(nil) != nil {
+		t.Error("expected nil card without commits")
+	}
+}
+
+func TestMakeSVGCard(t *testing.T) {
+	card := CommitDaysOfWeekCard(map[time.Weekday]int{time.Monday: 3, time.Friday: 1}, 4)
+
+	got := MakeSVGCard(card, SVGThemeAuto)
+
+	for _, want := range []string{
+		`<svg xmlns="http://www.w3.org/2000/svg"`,
+		"<title id=\"title\">📅 I&#39;m Most Productive on Monday</title>",
+		"@media (prefers-color-scheme: dark)",
+		">Monday</text>",
+		">3 commits</text>",
+		">75.00%</text>",
+		"</svg>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected SVG to contain %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestMakeSVGCardUsesFixedThemeAndRowColor(t *testing.T) {
+	card := LanguageAndToolCard(map[string][2]interface{}{"Go": {"#00ADD8", 10}}, 10)
+
+	got := MakeSVGCard(card, SVGThemeDark)
+
+	if strings.Contains(got, "prefers-color-scheme") {
+		t.Fatalf("fixed theme should not emit a media query:\n%s", got)
+	}
+	if !strings.Contains(got, `style="fill:#00ADD8"`) {
+		t.Fatalf("expected language color on bar, got:\n%s", got)
+	}
+}
+
+func TestMakeSVGCardReturnsEmptyForNilCard(t *testing.T) {
+	if got := MakeSVGCard(nil, SVGThemeAuto); got != "" {
+		t.Fatalf("expected empty string, got %q", got)
+	}
+}
+
+func TestMakeMermaidPie(t *testing.T) {
+	card := CommitDaysOfWeekCard(map[time.Weekday]int{time.Monday: 3, time.Friday: 1}, 4)
+
+	got := MakeMermaidPie(card)
+
+	want := "**📅 I'm Most Productive on Monday**\n\n```mermaid\npie showData\n" +
+		"    \"Monday\" : 3\n" +
+		"    \"Friday\" : 1\n" +
+		"```\n\n"
+	if got != want {
+		t.Fatalf("unexpected pie chart:\n%s", got)
+	}
+}
+
+func TestMakeMermaidXYChart(t *testing.T) {
+	card := &Card{
+		Title: "🔥 I Mostly Code in Go",
+		Groups: []Group{
+			{Rows: []Data{{Name: "Average:", Description: "2.5"}}, Stats: true},
+			{Rows: []Data{
+				{Name: "Go", Count: 5},
+				{Name: `Say "hi"`, Count: 0},
+			}},
+		},
+	}
+
+	got := MakeMermaidXYChart(card)
+	if strings.Contains(got, "Average") {
+		t.Errorf("expected stat rows to be left out of the chart, got:\n%s", got)
+	}
+
+	for _, want := range []string{
+		"```mermaid\nxychart-beta\n",
+		`    x-axis ["Go", "Say 'hi'"]`,
+		"    bar [5, 0]\n```",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected chart to contain %q, got:\n%s", want, got)
+		}
+	}
+
+	if MakeMermaidXYChart(nil) != "" || MakeMermaidPie(nil) != "" {
+		t.Error("expected empty output for a nil card")
+	}
+}
+
+func TestCommitCalendarCard(t *testing.T) {
+	now := time.Date(2026, 5, 20, 12, 0, 0, 0, time.UTC) // a Wednesday
+	commits := []github.Commit{
+		{CommittedDate: time.Date(2026, 5, 20, 8, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 20, 9, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 20, 10, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 20, 11, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 18, 8, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2025, 5, 17, 8, 0, 0, 0, time.UTC)}, // before the first week
+	}
+
+	card := CommitCalendarCard(commits, now)
+	if card == nil || card.Grid == nil {
+		t.Fatal("expected a heatmap card")
+	}
+
+	g := card.Grid
+	if card.Title != "📆 5 Commits in the Last Year" {
+		t.Errorf("unexpected title %q", card.Title)
+	}
+	if len(g.Columns) != 53 || len(g.Cells) != 7 {
+		t.Fatalf("expected a 7x53 grid, got %dx%d", len(g.Cells), len(g.Columns))
+	}
+	if g.Cells[3][52] != 4 || g.Cells[1][52] != 1 || g.Max != 4 {
+		t.Errorf("unexpected counts for the current week: %v (max %d)", []int{g.Cells[1][52], g.Cells[3][52]}, g.Max)
+	}
+	if g.Cells[4][52] != -1 {
+		t.Errorf("expected days after today to be outside the range, got %d", g.Cells[4][52])
+	}
+	if g.Level(4) != 4 || g.Level(1) != 1 || g.Level(0) != 0 {
+		t.Errorf("unexpected levels: %d %d %d", g.Level(0), g.Level(1), g.Level(4))
+	}
+	if g.Columns[0] != "" || g.Columns[2] != "Jun" {
+		t.Errorf("expected the partial first month to be unlabeled, got %q and %q", g.Columns[0], g.Columns[2])
+	}
+
+	text := MakeCommitCalendar(commits, now)
+	for _, want := range []string{
+		"**📆 5 Commits in the Last Year**\n\n```text\n",
+		"\nMon ····",
+		"·░\n",
+		"·█\n    ·",
+		"Less ·░▒▓█ More\n```",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected calendar to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	svg := MakeSVGCard(card, SVGThemeLight)
+	for _, want := range []string{`width="716"`, `class="l4"`, ">Jun</text>", ".l0{fill:#ebedf0}"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected SVG calendar to contain %q", want)
+		}
+	}
+
+	if CommitCalendarCard(commits[5:], now) != nil {
+		t.Error("expected nil card without commits in the last year")
+	}
+}
+
+func TestPunchCardCard(t *testing.T) {
+	loc := time.FixedZone("ICT", 7*60*60)
+	commits := []github.Commit{
+		{CommittedDate: time.Date(2026, 5, 15, 15, 0, 0, 0, time.UTC)}, // Friday 22:00 in loc
+		{CommittedDate: time.Date(2026, 5, 15, 15, 30, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 16, 23, 0, 0, 0, time.UTC)}, // Sunday 06:00 in loc
+	}
+
+	card := PunchCardCard(commits, loc)
+	if card == nil || card.Grid == nil {
+		t.Fatal("expected a heatmap card")
+	}
+
+	g := card.Grid
+	if card.Title != "🕰️ Most Commits on Friday at 22:00" {
+		t.Errorf("unexpected title %q", card.Title)
+	}
+	if len(g.Rows) != 7 || len(g.Columns) != 24 || g.Cells[5][22] != 2 || g.Cells[0][6] != 1 || g.Max != 2 {
+		t.Errorf("unexpected grid: %+v", g)
+	}
+
+	text := MakeCommitPunchCard(commits, loc)
+	for _, want := range []string{"\n    00 03 06 09 12 15 18 21\nSun ······▒", "\nFri " + strings.Repeat("·", 22) + "█·\n"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected punch card to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	svg := MakeSVGCard(card, SVGThemeLight)
+	if !strings.Contains(svg, `<circle class="l4"`) || strings.Contains(svg, "<rect class=\"l") {
+		t.Errorf("expected the SVG punch card to draw circles, got:\n%s", svg)
+	}
+
+	if PunchCardCard(nil, loc) != nil {
+		t.Error("expected nil card without commits")
+	}
+}
+
+func TestCommitTrendCard(t *testing.T) {
+	now := time.Date(2026, 5, 20, 12, 0, 0, 0, time.UTC) // a Wednesday
+	commits := []github.Commit{
+		{CommittedDate: time.Date(2025, 5, 31, 23, 0, 0, 0, time.UTC)}, // before the window
+		{CommittedDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2025, 9, 11, 0, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2025, 9, 12, 0, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2025, 9, 13, 0, 0, 0, 0, time.UTC)},
+		{CommittedDate: time.Date(2026, 5, 17, 9, 0, 0, 0, time.UTC)}, // the Sunday this week starts on
+		{CommittedDate: time.Date(2026, 5, 16, 9, 0, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name   string
+		window int
+		byWeek bool
+		want   []string
+	}{
+		{
+			name:   "months",
+			window: 12,
+			want: []string{
+				"**📈 Commit Trend, Last 12 Months**",
+				"\n▃▁▁█▁▁▁▁▁▁▁▅  Jun 2025 – May 2026\n",
+				"📉 Lowest:                0 commits (Jul 2025)",
+				"📈 Highest:               4 commits (Sep 2025)",
+				"📊 Average:               0.6 commits per month",
+			},
+		},
+		{
+			name:   "weeks",
+			window: 3,
+			byWeek: true,
+			want: []string{
+				"**📈 Commit Trend, Last 3 Weeks**",
+				"\n▁██  May 3, 2026 – May 17, 2026\n",
+				"📊 Average:               0.7 commits per week",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := MakeCommitTrend(commits, tt.window, tt.byWeek, now)
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("expected commit trend to contain %q, got:\n%s", want, text)
+				}
+			}
+		})
+	}
+
+	card := CommitTrendCard(commits, 12, false, now)
+	if rows := card.Groups[1].Rows; len(rows) != 12 || rows[3].Name != "Sep 2025" || rows[3].Count != 4 {
+		t.Errorf("unexpected monthly rows: %+v", rows)
+	}
+
+	if CommitTrendCard(nil, 12, false, now) != nil {
+		t.Error("expected nil card without commits")
+	}
+}
+
+func TestWakaDailyCard(t *testing.T) {
+	s := &wakatime.Summaries{}
+	for i, seconds := range []float64{9120, 0, 21780} {
+		d := wakatime.Summary{GrandTotal: wakatime.GrandTotal{TotalSeconds: seconds}}
+		d.Range.Date = time.Date(2026, 5, 11+i, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+		s.Data = append(s.Data, d)
+	}
+
+	text := MakeWakaDailyList(s, "1")
+	for _, want := range []string{
+		"**⌨️ Daily Coding Time, Last 3 Days**",
+		"🏆 Best Day:              6 hrs 3 mins (May 13, 2026)",
+		"📊 Daily Average:         2 hrs 51 mins",
+		"💪 Total Coding Time:     8 hrs 35 mins",
+		"\nMay 11, 2026              2 hrs 32 mins",
+		"\nMay 12, 2026              0 mins              ░░░░░░░░░░░░░░░░░░░░░░░░░   00.00%",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected daily coding time to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	card := WakaDailyCard(s)
+	if rows := card.Groups[1].Rows; len(rows) != 3 || rows[2].Count != 363 {
+		t.Errorf("unexpected daily rows: %+v", rows)
+	}
+
+	if WakaDailyCard(&wakatime.Summaries{Data: []wakatime.Summary{{}}}) != nil {
+		t.Error("expected nil card when nothing was coded")
+	}
+}
+
+func TestWakaGoalsCard(t *testing.T) {
+	period := func(actual, goal float64, status string) wakatime.GoalPeriod {
+		return wakatime.GoalPeriod{ActualSeconds: actual, GoalSeconds: goal, RangeStatus: status}
+	}
+	g := &wakatime.Goals{Data: []wakatime.Goal{
+		{Title: "Code 10 hrs per week", Delta: "week", IsEnabled: true, ChartData: []wakatime.GoalPeriod{period(40000, 36000, "success"), period(27900, 36000, "pending")}},
+		{Title: "Code 1 hr per day", Delta: "day", IsEnabled: true, ChartData: []wakatime.GoalPeriod{period(3900, 3600, "success"), period(4320, 3600, "success")}},
+		{Title: "Snoozed", IsEnabled: true, IsSnoozed: true, ChartData: []wakatime.GoalPeriod{period(0, 3600, "pending")}},
+		{Title: "Disabled", ChartData: []wakatime.GoalPeriod{period(0, 3600, "pending")}},
+	}}
+
+	text := MakeWakaGoalsList(g, "1")
+	for _, want := range []string{
+		"**🎯 Coding Goals**",
+		"\nCode 10 hrs per week      7:45 / 10:00        ███████████████████░░░░░░   77.50%",
+		"\nCode 1 hr per day         1:12 / 1:00         █████████████████████████   120.00%",
+		"\n🔥 Success Streaks\nCode 10 hrs per week      1 week",
+		"\nCode 1 hr per day         2 days",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected goals to contain %q, got:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Snoozed") || strings.Contains(text, "Disabled") {
+		t.Errorf("expected inactive goals to be left out, got:\n%s", text)
+	}
+
+	if WakaGoalsCard(&wakatime.Goals{Data: g.Data[2:]}) != nil {
+		t.Error("expected nil card without active goals")
+	}
+}
+
+func TestWakaActivityCardBreakdowns(t *testing.T) {
+	s := &wakatime.Stats{}
+	s.Data.Range = "last_7_days"
+	s.Data.Categories = []wakatime.StatsItem{
+		{Name: "Coding", Text: "10 hrs 5 mins", Hours: 10, Minutes: 5, Percent: 80},
+		{Name: "Debugging", Text: "2 hrs", Hours: 2, Percent: 16},
+		{Name: "Code Reviewing", Text: "5 mins", Minutes: 5, Percent: 4},
+	}
+	s.Data.Machines = []wakatime.StatsItem{{Name: "work-laptop", Text: "12 hrs", Hours: 12, Percent: 100}}
+	s.Data.Dependencies = []wakatime.StatsItem{{Name: "testing", Text: "1 hr", Hours: 1, Percent: 100}}
+	s.Data.Branches = []wakatime.StatsItem{{Name: "main", Text: "3 hrs", Hours: 3, Percent: 100}}
+	s.Data.TotalSeconds = 12*3600 + 10*60
+
+	card := WakaActivityCard(s, []string{"CATEGORIES", "MACHINES", "DEPENDENCIES", "BRANCHES"})
+	if len(card.Groups) != 4 {
+		t.Fatalf("expected a group per breakdown, got %+v", card.Groups)
+	}
+	if card.Headline != "12 hrs 10 mins" {
+		t.Errorf("expected the total time as headline, got %q", card.Headline)
+	}
+
+	categories := card.Groups[0]
+	if categories.Label != "🏷️ Categories:" || len(categories.Rows) != 3 {
+		t.Fatalf("unexpected categories group: %+v", categories)
+	}
+	if others := categories.Rows[2]; others.Name != "Others" || others.Description != "5 mins" || others.Percent != 4 {
+		t.Errorf("expected short categories folded into Others, got %+v", others)
+	}
+
+	for i, want := range []string{"🖥️ Machines:", "🧩 Dependencies:", "🌿 Branches:"} {
+		if g := card.Groups[i+1]; g.Label != want || len(g.Rows) != 1 {
+			t.Errorf("unexpected group %d: %+v", i+1, g)
+		}
+	}
+}
+
+func TestCommitSizesCard(t *testing.T) {
+	var commits []github.Commit
+	for _, lines := range []int{1, 9, 10, 49, 50, 120, 249, 250, 1000, 1001} {
+		commits = append(commits, github.Commit{Additions: lines - lines/3, Deletions: lines / 3})
+	}
+
+	text := MakeCommitSizesList(commits, "1")
+	for _, want := range []string{
+		"**📦 Commit Sizes**",
+		"📏 Median Commit:         50 lines",
+		"📐 90th Percentile:       1,000 lines",
+		"\nBy Lines Changed",
+		"\nXS (<10)                  2 commits           █████░░░░░░░░░░░░░░░░░░░░   20.00%",
+		"\nS (10–49)                 2 commits",
+		"\nM (50–249)                3 commits",
+		"\nL (250–1,000)             2 commits",
+		"\nXL (>1,000)               1 commit            ███░",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected commit sizes to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	if CommitSizesCard(nil) != nil {
+		t.Error("expected nil card without commits")
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	for p, want := range map[float64]int{0: 1, 50: 5, 90: 9, 95: 10, 100: 10} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("percentile(%v) = %d, want %d", p, got, want)
+		}
+	}
+}
+
+func TestTopRepositoriesCard(t *testing.T) {
+	repos := []github.Repository{
+		{Name: "site", Url: "https://github.com/alice/site"},
+		{Name: "tool", Url: "https://github.com/alice/tool"},
+		{Name: "secret-api", Url: "https://github.com/alice/secret-api", IsPrivate: true},
+		{Name: "secret-web", Url: "https://github.com/alice/secret-web", IsPrivate: true},
+	}
+	commit := func(repo string, lines int) github.Commit {
+		return github.Commit{Repository: "https://github.com/alice/" + repo, Additions: lines, Deletions: 0}
+	}
+	commits := []github.Commit{
+		commit("site", 1200), commit("site", 300), commit("site", 10),
+		commit("tool", 40),
+		commit("secret-api", 500), commit("secret-api", 500),
+		commit("secret-web", 2000),
+		{Repository: "https://github.com/alice/unknown", Additions: 1},
+	}
+
+	tests := []struct {
+		name        string
+		limit       int
+		hidePrivate bool
+		want        []string
+		notWant     []string
+	}{
+		{
+			name: "every repository by name",
+			want: []string{
+				"\nCommits · Lines Changed\nsite                      3 · 1.5K            ",
+				"\nsecret-api                2 · 1.0K            ",
+				"\nsecret-web                1 · 2.0K            ",
+				"\ntool                      1 · 40              ",
+			},
+		},
+		{
+			name:        "private repositories grouped",
+			limit:       2,
+			hidePrivate: true,
+			want:        []string{"\n🔒 Private repos          3 · 3.0K            ", "\nsite", "42.86%"},
+			notWant:     []string{"secret", "tool"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := MakeTopRepositoriesList(commits, repos, tt.limit, tt.hidePrivate, "1")
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("expected top repositories to contain %q, got:\n%s", want, text)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(text, notWant) {
+					t.Errorf("expected top repositories not to contain %q, got:\n%s", notWant, text)
+				}
+			}
+		})
+	}
+
+	if TopRepositoriesCard(nil, repos, 5, false) != nil {
+		t.Error("expected nil card without commits")
+	}
+}
+
+func TestProfileSummaryCard(t *testing.T) {
+	now := time.Date(2026, 5, 20, 12, 0, 0, 0, time.UTC)
+	created := time.Date(2019, 3, 14, 8, 0, 0, 0, time.UTC)
+	first := time.Date(2020, 1, 2, 9, 0, 0, 0, time.UTC)
+
+	text := MakeProfileSummaryList(created, first, 12, 30, 1234, 350, now)
+	for _, want := range []string{
+		"**👤 Profile Summary**",
+		"🎂 Joined GitHub:         Mar 14, 2019",
+		"🎉 Account Age:           7 years, 2 months",
+		"📦 Repositories:          12 owned / 30 contributed",
+		"🌱 First Commit:          Jan 2, 2020",
+		"💾 Total Commits:         1,234 commits",
+		"📅 Active Days:           350 days",
+		"📊 Commits per Day:       3.5",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected profile summary to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	card := ProfileSummaryCard(time.Time{}, time.Time{}, 2, 0, 0, 0, now)
+	if card == nil || len(card.Groups[0].Rows) != 1 {
+		t.Fatalf("expected only the repository row without an account date or commits, got %+v", card)
+	}
+
+	if ProfileSummaryCard(time.Time{}, time.Time{}, 0, 0, 0, 0, now) != nil {
+		t.Error("expected nil card without any data")
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	now := time.Date(2026, 5, 20, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		since time.Time
+		want  string
+	}{
+		{since: time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC), want: "10 days"},
+		{since: time.Date(2026, 4, 21, 0, 0, 0, 0, time.UTC), want: "29 days"},
+		{since: time.Date(2026, 4, 20, 0, 0, 0, 0, time.UTC), want: "1 month"},
+		{since: time.Date(2025, 5, 20, 0, 0, 0, 0, time.UTC), want: "1 year"},
+		{since: time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC), want: "3 years, 2 months"},
+	}
+
+	for _, tt := range tests {
+		if got := formatAge(tt.since, now); got != tt.want {
+			t.Errorf("formatAge(%s) = %q, want %q", tt.since.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
+
+func TestCommitsPerYearCard(t *testing.T) {
+	yearly := map[int]int{2022: 120, 2023: 310, 2025: 702, 2026: 533}
+
+	tests := []struct {
+		name  string
+		limit int
+		want  []string
+	}{
+		{
+			name: "all years with gaps filled",
+			want: []string{"2022", "2023 (+158.3%)", "2024 (-100.0%)", "2025", "2026 (-24.1%)"},
+		},
+		{
+			name:  "last two years",
+			limit: 2,
+			want:  []string{"2025", "2026 (-24.1%)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := CommitsPerYearCard(yearly, tt.limit)
+			rows := card.Groups[0].Rows
+			if len(rows) != len(tt.want) {
+				t.Fatalf("expected %d rows, got %+v", len(tt.want), rows)
+			}
+
+			total := 0.0
+			for i, r := range rows {
+				if r.Name != tt.want[i] {
+					t.Errorf("row %d: got %q, want %q", i, r.Name, tt.want[i])
+				}
+				total += r.Percent
+			}
+			if total < 99.99 || total > 100.01 {
+				t.Errorf("expected shares of the shown years to add up to 100%%, got %.2f", total)
+			}
+		})
+	}
+
+	if CommitsPerYearCard(nil, 0) != nil {
+		t.Error("expected nil card without commits")
+	}
+}
+
+func TestMakeCommitsPerQuarterList(t *testing.T) {
+	quarterly := map[string]int{"2025-Q1": 150, "2025-Q3": 201, "2026-Q1": 310}
+
+	got := MakeCommitsPerQuarterList(quarterly, 3, "1")
+
+	for _, want := range []string{
+		"**📈 Commits per Quarter**",
+		"\n2025-Q3                   201 commits",
+		"\n2025-Q4                   0 commits",
+		"\n2026-Q1 (+106.7%)         310 commits",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "2025-Q2") {
+		t.Errorf("expected the limit to drop older quarters, got:\n%s", got)
+	}
+}
+
+func TestMakeCodeChurnList(t *testing.T) {
+	now := time.Date(2026, 5, 20, 12, 0, 0, 0, time.UTC)
+	commits := []github.Commit{
+		{Additions: 1200, Deletions: 300, CommittedDate: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{Additions: 100, Deletions: 1400, CommittedDate: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{Additions: 50, Deletions: 0, CommittedDate: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name    string
+		byMonth bool
+		want    []string
+		notWant string
+	}{
+		{
+			name: "by year",
+			want: []string{
+				"➕ Lines Added:           1,350 lines\n",
+				"➖ Lines Deleted:         1,700 lines\n",
+				"📊 Net Change:            -350 lines\n",
+				"📏 Average Commit:        1,016 lines\n",
+				"\nBy Year\n2024                      +50 / -0",
+				"\n2025                      +1.2K / -300        ",
+				"\n2026                      +100 / -1.4K        ",
+			},
+		},
+		{
+			name:    "by month",
+			byMonth: true,
+			want: []string{
+				"\nBy Month\n2025-06                   +0 / -0",
+				"\n2025-08                   +1.2K / -300",
+				"\n2026-05                   +100 / -1.4K",
+			},
+			notWant: "2024-05",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MakeCodeChurnList(commits, tt.byMonth, now, "1")
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, got)
+				}
+			}
+			if tt.notWant != "" && strings.Contains(got, tt.notWant) {
+				t.Errorf("expected output not to contain %q, got:\n%s", tt.notWant, got)
+			}
+		})
+	}
+
+	if CodeChurnCard(nil, false, now) != nil {
+		t.Error("expected nil card without commits")
+	}
+}
+
+// useProgressBarStyle registers a progress bar style for the duration of the test
+func useProgressBarStyle(t *testing.T, name string, style ProgressBarStyle) {
+	t.Helper()
+
+	if err := RegisterProgressBarStyle(name, style); err != nil {
+		t.Fatalf("RegisterProgressBarStyle returned error: %v", err)
+	}
+	t.Cleanup(func() {
+		progressBarStylesMu.Lock()
+		delete(progressBarStyles, name)
+		progressBarStylesMu.Unlock()
+	})
+}
+
+func TestProgressBarStyles(t *testing.T) {
+	useProgressBarStyle(t, "test-thin", ProgressBarStyle{Filled: "▰", Empty: "▱", Width: 10})
+
+	tests := []struct {
+		name    string
+		style   string
+		percent float64
+		want    string
+	}{
+		{"blocks round to whole cells", ProgressBarBlocks, 50, strings.Repeat("█", 13) + strings.Repeat("░", 12)},
+		{"emoji show any remainder", ProgressBarEmoji, 41, strings.Repeat("🟩", 10) + "🟨" + strings.Repeat("⬜", 14)},
+		{"eighths", ProgressBarEighths, 50, strings.Repeat("█", 12) + "▌" + strings.Repeat("░", 12)},
+		{"eighths small remainder", ProgressBarEighths, 0.5, "▏" + strings.Repeat("░", 24)},
+		{"custom width", "test-thin", 35, "▰▰▰▰▱▱▱▱▱▱"},
+		{"clamped above 100", "test-thin", 140, "▰▰▰▰▰▰▰▰▰▰"},
+		{"unknown style falls back to blocks", "missing", 100, strings.Repeat("█", 25)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := makeProgressBar(tt.percent, tt.style); got != tt.want {
+				t.Errorf("makeProgressBar(%v, %q) = %q, want %q", tt.percent, tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseProgressBarStyle(t *testing.T) {
+	s, err := ParseProgressBarStyle("█|·|30|▏▎▍▌▋▊▉")
+	if err != nil {
+		t.Fatalf("ParseProgressBarStyle returned error: %v", err)
+	}
+	if s.Filled != "█" || s.Empty != "·" || s.Width != 30 || len(s.Partials) != 7 {
+		t.Errorf("unexpected style %+v", s)
+	}
+
+	s, err = ParseProgressBarStyle("🟦|⬜||🟪 🟨")
+	if err != nil || s.Width != graphLength || len(s.Partials) != 2 || s.Partials[0] != "🟪" {
+		t.Errorf("expected default width and space-separated partials, got %+v (%v)", s, err)
+	}
+
+	for _, def := range []string{"█", "█|░|wide", "█|░|0", "|░|10", "█|░|10|▌|extra"} {
+		if _, err := ParseProgressBarStyle(def); err == nil {
+			t.Errorf("expected an error for %q", def)
+		}
+	}
+
+	if err := RegisterProgressBarStyle(ProgressBarEmoji, ProgressBarStyle{Filled: "x", Empty: "-", Width: 5}); err == nil {
+		t.Error("expected built-in styles to be protected")
+	}
+}
+
+func TestSVGFileName(t *testing.T) {
+	if got := SVGFileName("COMMIT_DAYS_OF_WEEK"); got != "github-stats-commit-days-of-week.svg" {
+		t.Fatalf("SVGFileName() = %q", got)
+	}
+}
+
+func TestMakeTemplateHelpers(t *testing.T) {
+	got, err := MakeTemplate("test", `{{humanizeCount 1500}} {{addCommas 1500}} {{formatTime 3 5}} {{progressBar 100.0}}`, &TemplateData{}, func(string) string { return "1" })
+	if err != nil {
+		t.Fatalf("MakeTemplate returned error: %v", err)
+	}
+
+	want := "1.5K 1,500 3 hrs 5 mins " + strings.Repeat("█", graphLength)
+	if got != want {
+		t.Fatalf("MakeTemplate() = %q, want %q", got, want)
+	}
+}
+
+func TestLocalesTranslateEveryMessage(t *testing.T) {
+	for _, code := range Locales() {
+		l := locales[code]
+		for key := range localeEN.Messages {
+			if _, ok := l.Messages[key]; !ok {
+				t.Errorf("locale %s is missing message %q", code, key)
+			}
+		}
+		for unit := range localeEN.Units {
+			if len(l.Units[unit]) == 0 {
+				t.Errorf("locale %s is missing unit %q", code, unit)
+			}
+		}
+	}
+
+	if err := SetLocale("xx"); err == nil {
+		t.Error("expected an unknown locale to be rejected")
+	}
+	if currentLocale() != &localeEN {
+		t.Error("expected a rejected locale to keep the current one")
+	}
+}
+
+func TestUseLocaleRestoresPreviousLocale(t *testing.T) {
+	t.Run("vi", func(t *testing.T) {
+		useLocale(t, "vi")
+		if got := formatNumber(1234); got != "1.234" {
+			t.Errorf("formatNumber(1234) = %q in vi", got)
+		}
+	})
+
+	if got := formatNumber(1234); got != "1,234" {
+		t.Errorf("expected the default locale after the subtest, got %q", got)
+	}
+}
+
+// useLocale selects a locale for the duration of the test and restores the previous one afterwards
+func useLocale(t *testing.T, code string) {
+	t.Helper()
+
+	prev := currentLocale()
+	if err := SetLocale(code); err != nil {
+		t.Fatalf("SetLocale(%q) returned error: %v", code, err)
+	}
+	t.Cleanup(func() {
+		localeMu.Lock()
+		locale = prev
+		localeMu.Unlock()
+	})
+}
+
+func TestLocalizedOutput(t *testing.T) {
+
+	wd := map[time.Weekday]int{time.Monday: 1234, time.Tuesday: 1}
+	now := time.Date(2026, 5, 20, 12, 0, 0, 0, time.UTC)
+	commits := []github.Commit{{CommittedDate: time.Date(2026, 5, 18, 8, 0, 0, 0, time.UTC)}}
+
+	tests := []struct {
+		locale string
+		got    func() string
+		want   []string
+	}{
+		{
+			locale: "en",
+			got:    func() string { return MakeCommitDaysOfWeekList(wd, 1235, "1") },
+			want:   []string{"I'm Most Productive on Monday", "1,234 commits", "1 commit ", "0 commits", "99.92%"},
+		},
+		{
+			locale: "vi",
+			got:    func() string { return MakeCommitDaysOfWeekList(wd, 1235, "1") },
+			want:   []string{"Tôi làm việc hiệu quả nhất vào Thứ hai", "1.234 commit", "99,92%"},
+		},
+		{
+			locale: "ja",
+			got:    func() string { return MakeCommitDaysOfWeekList(wd, 1235, "1") },
+			want:   []string{"最も生産的なのは月曜日", "1,234 コミット", "99.92%"},
+		},
+		{
+			locale: "vi",
+			got:    func() string { return MakeCommitCalendar(commits, now) },
+			want:   []string{"1 commit trong năm qua", "\nT2 ····", "Th10     Th12", "Ít ·░▒▓█ Nhiều"},
+		},
+		{
+			locale: "ja",
+			got:    func() string { return MakeCommitCalendar(commits, now) },
+			want:   []string{"過去1年間で1 コミット", "\n月 ", "6月", "少 ·░▒▓█ 多"},
+		},
+		{
+			locale: "vi",
+			got:    func() string { return humanizeCount(1500) + " " + formatTime(2, 5) },
+			want:   []string{"1,5K 2 giờ 5 phút"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			useLocale(t, tt.locale)
+
+			got := tt.got()
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCalendarHeaderSkipsOverlappingWideLabels(t *testing.T) {
+	g := &Grid{
+		Rows:    []string{"月"},
+		Columns: []string{"10月", "", "11月", "", "", "", "12月"},
+		Cells:   [][]int{{0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	got := makeGridBlock("title", g)
+	if !strings.Contains(got, "\n   10月  12月\n") {
+		t.Errorf("expected the overlapping label to be skipped, got:\n%s", got)
+	}
+}
+
+func TestApplyLayout(t *testing.T) {
+	block := "**📈 Coding Streak**\n\n```text\nrows\n```\n\n"
+	card := &Card{Title: "📈 Coding Streak", Headline: "14 days"}
+
+	tests := []struct {
+		name   string
+		layout Layout
+		want   string
+	}{
+		{
+			name: "no layout keeps the block",
+			want: block,
+		},
+		{
+			name:   "heading replaces the bold title",
+			layout: Layout{Heading: 2},
+			want:   "## 📈 Coding Streak\n\n```text\nrows\n```\n\n",
+		},
+		{
+			name:   "details moves the title into the summary",
+			layout: Layout{Heading: 2, Details: true},
+			want:   "<details>\n<summary>📈 Coding Streak</summary>\n\n```text\nrows\n```\n\n</details>\n\n",
+		},
+		{
+			name:   "custom summary keeps the title inside",
+			layout: Layout{Heading: 4, Details: true, Summary: "{title} & more"},
+			want:   "<details>\n<summary>📈 Coding Streak &amp; more</summary>\n\n#### 📈 Coding Streak\n\n```text\nrows\n```\n\n</details>\n\n",
+		},
+		{
+			name:   "custom summary shows the headline",
+			layout: Layout{Details: true, Summary: "Streak: {value}"},
+			want:   "<details>\n<summary>Streak: 14 days</summary>\n\n**📈 Coding Streak**\n\n```text\nrows\n```\n\n</details>\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ApplyLayout(block, card, tt.layout); got != tt.want {
+				t.Fatalf("ApplyLayout() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	embed := MakeSVGEmbed("streak.svg", "📈 Coding Streak")
+	if got := ApplyLayout(embed, card, Layout{Heading: 2}); got != embed {
+		t.Errorf("expected a block without a title line to be unchanged, got %q", got)
+	}
+	if got := ApplyLayout("", card, Layout{Details: true}); got != "" {
+		t.Errorf("expected empty output to stay empty, got %q", got)
+	}
+}
+
+func TestMakeMarkdownTable(t *testing.T) {
+	wd := map[time.Weekday]int{time.Monday: 3, time.Tuesday: 1}
+	got := MakeMarkdownTable(CommitDaysOfWeekCard(wd, 4), "1")
+	for _, want := range []string{
+		"**📅 I'm Most Productive on Monday**\n\n| Name | Value | Progress | Percent |\n| :--- | :--- | :--- | ---: |\n",
+		"| Monday | 3 commits | `" + makeProgressBar(75, "1") + "` | 75.00% |\n",
+		"| Saturday | 0 commits |",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected table to contain %q, got:\n%s", want, got)
+		}
+	}
+
+	stats := MakeMarkdownTable(&Card{Title: "Stats", Groups: []Group{{Rows: []Data{{Name: "A | B:", Description: "1 day"}}, Stats: true}}}, "1")
+	if !strings.Contains(stats, "| Name | Value |\n| :--- | :--- |\n| A \\| B | 1 day |\n") {
+		t.Errorf("expected an escaped stat table, got:\n%s", stats)
+	}
+
+	waka := MakeMarkdownTable(&Card{Title: "Waka", Groups: []Group{{Label: "💬 Languages:"}, {Label: "📝 Editors:", Rows: []Data{{Name: "VS Code", Description: "1 hr", Percent: 100}}}}}, "1")
+	for _, want := range []string{"💬 Languages:\n\nNo data available\n\n", "| 📝 Editors | Value | Progress | Percent |\n"} {
+		if !strings.Contains(waka, want) {
+			t.Errorf("expected grouped tables to contain %q, got:\n%s", want, waka)
+		}
+	}
+
+	if MakeMarkdownTable(nil, "1") != "" {
+		t.Error("expected empty output for a nil card")
+	}
+}